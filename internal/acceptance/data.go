@@ -11,6 +11,7 @@ import (
 	"strconv"
 	"testing"
 
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/recording"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
 )
 
@@ -80,6 +81,25 @@ func BuildTestData(t *testing.T, resourceType string, resourceLabel string) Test
 		}
 	}
 
+	if recorder := recording.Current(); recorder != nil {
+		// when recording the values for this test are stored alongside the recording, and when replaying these
+		// are restored from it so that the same requests are sent
+		values := recorder.StartTest(t, recording.TestValues{
+			RandomInteger: testData.RandomInteger,
+			RandomString:  testData.RandomString,
+			Locations:     []string{testData.Locations.Primary, testData.Locations.Secondary, testData.Locations.Ternary},
+		})
+		testData.RandomInteger = values.RandomInteger
+		testData.RandomString = values.RandomString
+		if len(values.Locations) == 3 {
+			testData.Locations = Regions{
+				Primary:   values.Locations[0],
+				Secondary: values.Locations[1],
+				Ternary:   values.Locations[2],
+			}
+		}
+	}
+
 	testData.Subscriptions = Subscriptions{
 		Primary:   os.Getenv("ARM_SUBSCRIPTION_ID"),
 		Secondary: os.Getenv("ARM_TEST_SUBSCRIPTION_ID_ALT"),
@@ -0,0 +1,118 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fakearm
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/recording"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/testclient"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+const (
+	// SubscriptionId is the ID of the Subscription used by the Client for the fake Resource Manager
+	SubscriptionId = "00000000-0000-0000-0000-000000000000"
+
+	tenantId = "00000000-0000-0000-0000-000000000001"
+	clientId = "00000000-0000-0000-0000-000000000002"
+	objectId = "00000000-0000-0000-0000-000000000003"
+)
+
+// Client returns a Client which sends requests to this fake Resource Manager
+func (s *Server) Client(t *testing.T) *clients.Client {
+	t.Helper()
+
+	// the lock for the resources can't be held here, since building the client sends requests to the fake
+	s.clientLock.Lock()
+	defer s.clientLock.Unlock()
+
+	if s.client == nil {
+		authorizer := recording.OfflineAuthorizer{
+			TenantId: tenantId,
+			ClientId: clientId,
+			ObjectId: objectId,
+		}
+		client, err := testclient.BuildForEnvironment(context.Background(), *s.Environment(), SubscriptionId, authorizer)
+		if err != nil {
+			t.Fatalf("building client for the fake Resource Manager: %+v", err)
+		}
+		s.client = client
+	}
+
+	return s.client
+}
+
+// ResourceTest runs the Create, Read, Update and Delete functions of the Resource against this fake Resource Manager,
+// checking that each value within the configuration is read back into the state. The Update is skipped when
+// the Resource doesn't support updates or when updatedConfig is nil.
+func (s *Server) ResourceTest(t *testing.T, resource sdk.Resource, config map[string]interface{}, updatedConfig map[string]interface{}) {
+	t.Helper()
+
+	client := s.Client(t)
+
+	wrapper := sdk.NewResourceWrapper(resource)
+	r, err := wrapper.Resource()
+	if err != nil {
+		t.Fatalf("building %s: %+v", resource.ResourceType(), err)
+	}
+
+	d := schema.TestResourceDataRaw(t, r.Schema, config)
+	if diags := r.CreateContext(contextWithTimeout(t, d, pluginsdk.TimeoutCreate), d, client); diags.HasError() {
+		t.Fatalf("creating %s: %+v", resource.ResourceType(), diags)
+	}
+	id := d.Id()
+	if _, ok := s.Resource(id); !ok {
+		t.Fatalf("expected %q to exist once created", id)
+	}
+	checkResourceData(t, d, config)
+
+	if r.UpdateContext != nil && updatedConfig != nil {
+		d = schema.TestResourceDataRaw(t, r.Schema, updatedConfig)
+		d.SetId(id)
+		if diags := r.UpdateContext(contextWithTimeout(t, d, pluginsdk.TimeoutUpdate), d, client); diags.HasError() {
+			t.Fatalf("updating %s: %+v", id, diags)
+		}
+		checkResourceData(t, d, updatedConfig)
+	}
+
+	if diags := r.DeleteContext(contextWithTimeout(t, d, pluginsdk.TimeoutDelete), d, client); diags.HasError() {
+		t.Fatalf("deleting %s: %+v", id, diags)
+	}
+	if _, ok := s.Resource(id); ok {
+		t.Fatalf("expected %q to have been deleted", id)
+	}
+
+	d = schema.TestResourceDataRaw(t, r.Schema, config)
+	d.SetId(id)
+	if diags := r.ReadContext(contextWithTimeout(t, d, pluginsdk.TimeoutRead), d, client); diags.HasError() {
+		t.Fatalf("reading %s once deleted: %+v", id, diags)
+	}
+	if d.Id() != "" {
+		t.Fatalf("expected %q to be removed from the state once deleted", id)
+	}
+}
+
+// contextWithTimeout returns a Context with the Resource's timeout for the operation, as the Plugin SDK does
+func contextWithTimeout(t *testing.T, d *pluginsdk.ResourceData, operation string) context.Context {
+	ctx, cancel := context.WithTimeout(context.Background(), d.Timeout(operation))
+	t.Cleanup(cancel)
+	return ctx
+}
+
+// checkResourceData checks that each value within the configuration has been read back into the state
+func checkResourceData(t *testing.T, d *pluginsdk.ResourceData, config map[string]interface{}) {
+	t.Helper()
+
+	for key, expected := range config {
+		if actual := d.Get(key); !reflect.DeepEqual(actual, expected) {
+			t.Fatalf("expected %q to be %+v but got %+v", key, expected, actual)
+		}
+	}
+}
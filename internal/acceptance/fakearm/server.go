@@ -0,0 +1,367 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fakearm
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/environments"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+)
+
+// operationsPathPrefix is the path used for the status of Long Running Operations
+const operationsPathPrefix = "/fakearm/operations/"
+
+// Server is an in-process fake of Azure Resource Manager, which supports creating (PUT), updating (PATCH), retrieving
+// (GET), listing (GET on a collection) and deleting (DELETE) any resource by its Resource ID - allowing the CRUD
+// functions of a Resource to be tested without access to Azure.
+//
+// Resources are stored as-is, with the `id`, `name` and `type` fields populated from the Resource ID and the
+// `provisioningState` set to `Succeeded`. When LongRunningOperations is enabled, PUT, PATCH and DELETE requests return
+// an `Azure-AsyncOperation` header which must be polled until the operation has completed, as Resource Manager does.
+type Server struct {
+	// LongRunningOperations specifies whether PUT, PATCH and DELETE requests complete asynchronously
+	LongRunningOperations bool
+
+	// PollsUntilComplete is the number of times each Long Running Operation must be polled before it completes
+	PollsUntilComplete int
+
+	server *httptest.Server
+
+	clientLock sync.Mutex
+	client     *clients.Client
+
+	lock       sync.Mutex
+	resources  map[string]map[string]interface{}
+	operations map[string]*operation
+}
+
+type operation struct {
+	// polls is the number of times this operation has been polled
+	polls int
+}
+
+// NewServer starts a new fake Resource Manager, which must be closed once the test is complete
+func NewServer() *Server {
+	s := &Server{
+		PollsUntilComplete: 1,
+		resources:          make(map[string]map[string]interface{}),
+		operations:         make(map[string]*operation),
+	}
+	s.server = httptest.NewServer(http.HandlerFunc(s.handle))
+	return s
+}
+
+// Close shuts down the fake Resource Manager
+func (s *Server) Close() {
+	s.server.Close()
+}
+
+// URL returns the base URL of the fake Resource Manager
+func (s *Server) URL() string {
+	return s.server.URL
+}
+
+// Environment returns an Azure Environment with the Resource Manager endpoint pointing at this fake
+func (s *Server) Environment() *environments.Environment {
+	env := environments.AzurePublic()
+	env.Name = "FakeResourceManager"
+	env.ResourceManager = environments.ResourceManagerAPI(s.server.URL)
+	return env
+}
+
+// Resource returns the resource with the specified Resource ID, if it exists
+func (s *Server) Resource(id string) (map[string]interface{}, bool) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	resource, ok := s.resources[strings.ToLower(id)]
+	if !ok {
+		return nil, false
+	}
+
+	return copyResource(resource), true
+}
+
+// PutResource creates or replaces the resource with the specified Resource ID, for example to create any
+// dependencies of the resource being tested
+func (s *Server) PutResource(id string, resource map[string]interface{}) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.resources[strings.ToLower(id)] = normalizeResource(id, copyResource(resource))
+}
+
+func (s *Server) handle(w http.ResponseWriter, r *http.Request) {
+	if strings.HasPrefix(r.URL.Path, operationsPathPrefix) {
+		s.handleOperation(w, r)
+		return
+	}
+
+	id := strings.TrimSuffix(r.URL.Path, "/")
+	switch r.Method {
+	case http.MethodGet:
+		s.handleGet(w, id)
+	case http.MethodPut, http.MethodPatch:
+		s.handlePutOrPatch(w, r, id)
+	case http.MethodDelete:
+		s.handleDelete(w, r, id)
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "MethodNotAllowed", fmt.Sprintf("the fake Resource Manager doesn't support %s requests", r.Method))
+	}
+}
+
+func (s *Server) handleGet(w http.ResponseWriter, id string) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if resource, ok := s.resources[strings.ToLower(id)]; ok {
+		writeJSON(w, http.StatusOK, resource)
+		return
+	}
+
+	// Resource IDs contain an even number of segments (e.g. `/subscriptions/{id}`) whereas collections contain an
+	// odd number (e.g. `/subscriptions/{id}/resourceGroups`)
+	if segments := strings.Split(strings.Trim(id, "/"), "/"); len(segments)%2 == 1 {
+		writeJSON(w, http.StatusOK, map[string]interface{}{
+			"value": s.listResources(id),
+		})
+		return
+	}
+
+	writeError(w, http.StatusNotFound, "ResourceNotFound", fmt.Sprintf("The Resource %q was not found.", id))
+}
+
+// listResources returns the resources directly within the collection, the lock must be held by the caller
+func (s *Server) listResources(collectionId string) []map[string]interface{} {
+	prefix := strings.ToLower(strings.TrimSuffix(collectionId, "/")) + "/"
+
+	keys := make([]string, 0)
+	for key := range s.resources {
+		if strings.HasPrefix(key, prefix) && !strings.Contains(strings.TrimPrefix(key, prefix), "/") {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	resources := make([]map[string]interface{}, 0)
+	for _, key := range keys {
+		resources = append(resources, s.resources[key])
+	}
+	return resources
+}
+
+func (s *Server) handlePutOrPatch(w http.ResponseWriter, r *http.Request, id string) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "InvalidRequestContent", fmt.Sprintf("reading request body: %+v", err))
+		return
+	}
+
+	resource := make(map[string]interface{})
+	if len(body) > 0 {
+		if err := json.Unmarshal(body, &resource); err != nil {
+			writeError(w, http.StatusBadRequest, "InvalidRequestContent", fmt.Sprintf("parsing request body: %+v", err))
+			return
+		}
+	}
+
+	s.lock.Lock()
+	key := strings.ToLower(id)
+	existing, exists := s.resources[key]
+	if r.Method == http.MethodPatch {
+		if !exists {
+			s.lock.Unlock()
+			writeError(w, http.StatusNotFound, "ResourceNotFound", fmt.Sprintf("The Resource %q was not found.", id))
+			return
+		}
+		// the existing resource is copied since the merged resource can share nested values with it
+		resource = mergePatch(copyResource(existing), resource).(map[string]interface{})
+	}
+	resource = normalizeResource(id, resource)
+	s.resources[key] = resource
+	// the response is copied since the stored resource can be modified by subsequent requests once unlocked
+	resource = copyResource(resource)
+	s.lock.Unlock()
+
+	statusCode := http.StatusOK
+	if !exists {
+		statusCode = http.StatusCreated
+	}
+
+	if s.LongRunningOperations {
+		w.Header().Set("Azure-AsyncOperation", s.newOperation(r))
+		w.Header().Set("Retry-After", "0")
+		if statusCode == http.StatusOK {
+			statusCode = http.StatusAccepted
+		}
+	}
+
+	writeJSON(w, statusCode, resource)
+}
+
+func (s *Server) handleDelete(w http.ResponseWriter, r *http.Request, id string) {
+	s.lock.Lock()
+	key := strings.ToLower(id)
+	_, exists := s.resources[key]
+	for k := range s.resources {
+		// deleting a resource also deletes any nested resources, as with Resource Groups
+		if k == key || strings.HasPrefix(k, key+"/") {
+			delete(s.resources, k)
+		}
+	}
+	s.lock.Unlock()
+
+	if !exists {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	if s.LongRunningOperations {
+		w.Header().Set("Location", s.newOperation(r))
+		w.Header().Set("Retry-After", "0")
+		w.WriteHeader(http.StatusAccepted)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+// newOperation registers a new Long Running Operation and returns the URI used to poll it
+func (s *Server) newOperation(r *http.Request) string {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	name := fmt.Sprintf("operation-%d", len(s.operations)+1)
+	s.operations[name] = &operation{}
+
+	return fmt.Sprintf("%s%s%s?api-version=%s", s.server.URL, operationsPathPrefix, name, r.URL.Query().Get("api-version"))
+}
+
+func (s *Server) handleOperation(w http.ResponseWriter, r *http.Request) {
+	name := strings.TrimPrefix(r.URL.Path, operationsPathPrefix)
+
+	s.lock.Lock()
+	polls := 0
+	op, ok := s.operations[name]
+	if ok {
+		op.polls++
+		polls = op.polls
+	}
+	s.lock.Unlock()
+
+	if !ok {
+		writeError(w, http.StatusNotFound, "OperationNotFound", fmt.Sprintf("The Operation %q was not found.", name))
+		return
+	}
+
+	if polls < s.PollsUntilComplete {
+		w.Header().Set("Retry-After", "0")
+		writeJSON(w, http.StatusAccepted, map[string]interface{}{
+			"name":   name,
+			"status": "InProgress",
+		})
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"name":   name,
+		"status": "Succeeded",
+	})
+}
+
+// normalizeResource populates the fields which Resource Manager returns for every resource
+func normalizeResource(id string, resource map[string]interface{}) map[string]interface{} {
+	segments := strings.Split(strings.Trim(id, "/"), "/")
+
+	resource["id"] = id
+	resource["name"] = segments[len(segments)-1]
+	resource["type"] = resourceTypeForId(segments)
+
+	properties, ok := resource["properties"].(map[string]interface{})
+	if !ok {
+		properties = make(map[string]interface{})
+	}
+	properties["provisioningState"] = "Succeeded"
+	resource["properties"] = properties
+
+	return resource
+}
+
+// resourceTypeForId returns the type of the resource (e.g. `Microsoft.Network/virtualNetworks/subnets`)
+func resourceTypeForId(segments []string) string {
+	for i := len(segments) - 2; i >= 0; i-- {
+		if strings.EqualFold(segments[i], "providers") && i+1 < len(segments) {
+			types := []string{segments[i+1]}
+			for j := i + 2; j < len(segments); j += 2 {
+				types = append(types, segments[j])
+			}
+			return strings.Join(types, "/")
+		}
+	}
+
+	if len(segments) >= 2 && strings.EqualFold(segments[len(segments)-2], "resourceGroups") {
+		return "Microsoft.Resources/resourceGroups"
+	}
+
+	return "Microsoft.Resources/subscriptions"
+}
+
+// copyResource returns a deep copy of the resource
+func copyResource(resource map[string]interface{}) map[string]interface{} {
+	output := make(map[string]interface{})
+	if b, err := json.Marshal(resource); err == nil {
+		json.Unmarshal(b, &output) //nolint:errcheck
+	}
+	return output
+}
+
+// mergePatch applies the patch to the target as a JSON Merge Patch (RFC 7396)
+func mergePatch(target interface{}, patch interface{}) interface{} {
+	patchMap, ok := patch.(map[string]interface{})
+	if !ok {
+		return patch
+	}
+
+	targetMap, ok := target.(map[string]interface{})
+	if !ok {
+		targetMap = make(map[string]interface{})
+	}
+
+	output := make(map[string]interface{}, len(targetMap))
+	for k, v := range targetMap {
+		output[k] = v
+	}
+	for k, v := range patchMap {
+		if v == nil {
+			delete(output, k)
+			continue
+		}
+		output[k] = mergePatch(output[k], v)
+	}
+
+	return output
+}
+
+func writeJSON(w http.ResponseWriter, statusCode int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(statusCode)
+	json.NewEncoder(w).Encode(body) //nolint:errcheck
+}
+
+func writeError(w http.ResponseWriter, statusCode int, code, message string) {
+	writeJSON(w, statusCode, map[string]interface{}{
+		"error": map[string]interface{}{
+			"code":    code,
+			"message": message,
+		},
+	})
+}
@@ -0,0 +1,134 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fakearm
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/resources/2023-07-01/resourcegroups"
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/hashicorp/go-azure-sdk/sdk/client/resourcemanager"
+	"github.com/hashicorp/go-azure-sdk/sdk/environments"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/recording"
+)
+
+func TestServerCRUD(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+	client := testResourceGroupsClient(t, server)
+	id := commonids.NewResourceGroupID(SubscriptionId, "acctestRG")
+
+	existing, err := client.Get(ctx, id)
+	if !response.WasNotFound(existing.HttpResponse) {
+		t.Fatalf("expected a 404 for a Resource Group which doesn't exist but got %+v", err)
+	}
+
+	if _, err := client.CreateOrUpdate(ctx, id, resourcegroups.ResourceGroup{
+		Location: "westeurope",
+		Tags: pointer.To(map[string]string{
+			"environment": "test",
+		}),
+	}); err != nil {
+		t.Fatalf("creating %s: %+v", id, err)
+	}
+
+	if _, err := client.Update(ctx, id, resourcegroups.ResourceGroupPatchable{
+		Tags: pointer.To(map[string]string{
+			"environment": "production",
+		}),
+	}); err != nil {
+		t.Fatalf("updating %s: %+v", id, err)
+	}
+
+	resp, err := client.Get(ctx, id)
+	if err != nil {
+		t.Fatalf("retrieving %s: %+v", id, err)
+	}
+	model := resp.Model
+	if model == nil || model.Properties == nil || pointer.From(model.Properties.ProvisioningState) != "Succeeded" {
+		t.Fatalf("expected %s to have been provisioned but got %+v", id, model)
+	}
+	if model.Location != "westeurope" || pointer.From(model.Tags)["environment"] != "production" {
+		t.Fatalf("expected the location to be retained and the tags to be updated for %s but got %+v", id, model)
+	}
+
+	subnetId := commonids.NewSubnetID(SubscriptionId, id.ResourceGroupName, "acctestvnet", "acctestsubnet")
+	server.PutResource(subnetId.ID(), map[string]interface{}{})
+
+	if err := client.DeleteThenPoll(ctx, id, resourcegroups.DefaultDeleteOperationOptions()); err != nil {
+		t.Fatalf("deleting %s: %+v", id, err)
+	}
+	if _, ok := server.Resource(id.ID()); ok {
+		t.Fatalf("expected %s to have been deleted", id)
+	}
+	if _, ok := server.Resource(subnetId.ID()); ok {
+		t.Fatalf("expected %s to have been deleted along with the Resource Group", subnetId)
+	}
+}
+
+func TestServerLongRunningOperations(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	server.LongRunningOperations = true
+	server.PollsUntilComplete = 3
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+	resourceGroupsClient := testResourceGroupsClient(t, server)
+	id := commonids.NewResourceGroupID(SubscriptionId, "acctestRG")
+	server.PutResource(id.ID(), map[string]interface{}{
+		"location": "westeurope",
+	})
+
+	req, err := resourceGroupsClient.Client.NewRequest(ctx, client.RequestOptions{
+		ContentType: "application/json; charset=utf-8",
+		ExpectedStatusCodes: []int{
+			http.StatusAccepted,
+		},
+		HttpMethod: http.MethodPut,
+		Path:       id.ID(),
+	})
+	if err != nil {
+		t.Fatalf("building request: %+v", err)
+	}
+	if err := req.Marshal(map[string]interface{}{"location": "westeurope"}); err != nil {
+		t.Fatalf("marshaling request: %+v", err)
+	}
+
+	resp, err := req.Execute(ctx)
+	if err != nil {
+		t.Fatalf("updating %s: %+v", id, err)
+	}
+
+	poller, err := resourcemanager.PollerFromResponse(resp, resourceGroupsClient.Client)
+	if err != nil {
+		t.Fatalf("building poller: %+v", err)
+	}
+	if err := poller.PollUntilDone(ctx); err != nil {
+		t.Fatalf("polling after updating %s: %+v", id, err)
+	}
+
+	if polls := server.operations["operation-1"].polls; polls != server.PollsUntilComplete {
+		t.Fatalf("expected the operation to be polled %d times but got %d", server.PollsUntilComplete, polls)
+	}
+}
+
+func testResourceGroupsClient(t *testing.T, server *Server) *resourcegroups.ResourceGroupsClient {
+	client, err := resourcegroups.NewResourceGroupsClientWithBaseURI(environments.ResourceManagerAPI(server.URL()))
+	if err != nil {
+		t.Fatalf("building client: %+v", err)
+	}
+	client.Client.SetAuthorizer(recording.OfflineAuthorizer{})
+
+	return client
+}
@@ -0,0 +1,485 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package recording
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/auth"
+	"github.com/hashicorp/go-azure-sdk/sdk/claims"
+	"golang.org/x/oauth2"
+)
+
+const (
+	// EnvRecordingMode is the Environment Variable used to enable recording (`record`) or replaying (`replay`)
+	// the requests sent to Azure during the Acceptance Tests
+	EnvRecordingMode = "ARM_TEST_RECORDING_MODE"
+
+	// EnvRecordingsPath is the Environment Variable used to override the directory containing the recordings,
+	// which defaults to `testdata/recordings` within the package being tested
+	EnvRecordingsPath = "ARM_TEST_RECORDINGS_PATH"
+
+	defaultRecordingsPath = "testdata/recordings"
+
+	// sharedRecordingName is the name of the recording containing requests which can't be attributed to a single
+	// test, for example registering Resource Providers
+	sharedRecordingName = "shared"
+)
+
+type Mode string
+
+const (
+	// ModeRecord sends requests to Azure as normal, recording the (sanitised) requests and responses
+	ModeRecord Mode = "record"
+
+	// ModeReplay returns the recorded responses without sending any requests to Azure
+	ModeReplay Mode = "replay"
+)
+
+// TestValues are the values generated for each test which must be identical when the test is replayed,
+// since these are used in the names (and therefore URIs) of the resources being tested
+type TestValues struct {
+	RandomInteger int      `json:"random_integer"`
+	RandomString  string   `json:"random_string"`
+	Locations     []string `json:"locations"`
+}
+
+// Recorder records the requests sent to (and the responses returned from) Azure during the Acceptance Tests, so
+// that these can be replayed later without access to Azure.
+//
+// Requests are attributed to the test which made them by looking for the test's RandomInteger (or a value derived
+// from it) or RandomString in the request URI - as such each test's recording is keyed by its RandomInteger and
+// stored alongside the test under its name. Requests which can't be attributed to a test are stored in a shared
+// recording for the package.
+type Recorder struct {
+	mode Mode
+	path string
+
+	lock       sync.Mutex
+	recordings map[string]*Recording
+
+	// matchers are used to attribute requests to the recording for a test, ordered from most to least specific
+	matchers []matcher
+
+	// replacements are the values which are sanitised from requests and responses, keyed by the (lower-cased) value
+	replacements map[string]string
+
+	// server serves the recorded responses when replaying
+	server *httptest.Server
+}
+
+// originalRequestKey is the Context key for the original request when replaying
+type originalRequestKey struct{}
+
+type matcher struct {
+	value         string
+	recordingName string
+}
+
+var (
+	current     *Recorder
+	currentOnce sync.Once
+)
+
+// Current returns the Recorder for this test run, or nil when requests are neither being recorded nor replayed
+func Current() *Recorder {
+	currentOnce.Do(func() {
+		mode := Mode(strings.ToLower(os.Getenv(EnvRecordingMode)))
+		if mode == "" {
+			return
+		}
+
+		path := os.Getenv(EnvRecordingsPath)
+		if path == "" {
+			path = defaultRecordingsPath
+		}
+
+		recorder, err := NewRecorder(mode, path)
+		if err != nil {
+			panic(fmt.Sprintf("configuring the HTTP Recorder: %+v", err))
+		}
+
+		current = recorder
+	})
+
+	return current
+}
+
+// NewRecorder returns a Recorder which records or replays requests using the recordings stored within path
+func NewRecorder(mode Mode, path string) (*Recorder, error) {
+	if mode != ModeRecord && mode != ModeReplay {
+		return nil, fmt.Errorf("expected %s to be %q or %q but got %q", EnvRecordingMode, ModeRecord, ModeReplay, mode)
+	}
+
+	recorder := &Recorder{
+		mode:         mode,
+		path:         path,
+		recordings:   make(map[string]*Recording),
+		replacements: make(map[string]string),
+	}
+
+	if mode == ModeReplay {
+		// the recordings only contain placeholder values, so these are used in place of any real values
+		for _, v := range []string{"ARM_SUBSCRIPTION_ID", "ARM_TEST_SUBSCRIPTION_ID_ALT", "ARM_TENANT_ID", "ARM_CLIENT_ID"} {
+			if os.Getenv(v) == "" {
+				os.Setenv(v, placeholders[v])
+			}
+		}
+
+		recording, err := loadRecording(recorder.fileName(sharedRecordingName))
+		if err != nil && !os.IsNotExist(err) {
+			return nil, err
+		}
+		if recording == nil {
+			recording = &Recording{}
+		}
+		recorder.recordings[sharedRecordingName] = recording
+		recorder.server = httptest.NewServer(http.HandlerFunc(recorder.serveRecordedResponse))
+	} else {
+		recorder.recordings[sharedRecordingName] = &Recording{}
+	}
+
+	for _, v := range []string{"ARM_SUBSCRIPTION_ID", "ARM_TEST_SUBSCRIPTION_ID_ALT", "ARM_TENANT_ID", "ARM_CLIENT_ID", "ARM_CLIENT_SECRET"} {
+		recorder.addReplacement(os.Getenv(v), placeholders[v])
+	}
+
+	return recorder, nil
+}
+
+// Mode returns whether requests are being recorded or replayed
+func (r *Recorder) Mode() Mode {
+	return r.mode
+}
+
+// Replaying returns whether the recorded responses are being replayed, in which case no requests are sent to Azure
+func (r *Recorder) Replaying() bool {
+	return r != nil && r.mode == ModeReplay
+}
+
+// Authorizer returns the Authorizer which should be used to authenticate requests - when replaying this returns an
+// Authorizer which doesn't require access to Azure, otherwise this returns nil and requests are authenticated as normal
+func (r *Recorder) Authorizer() auth.Authorizer {
+	if !r.Replaying() {
+		return nil
+	}
+
+	return OfflineAuthorizer{
+		TenantId: placeholderTenantId,
+		ClientId: placeholderClientId,
+		ObjectId: placeholderObjectId,
+	}
+}
+
+// StartTest begins recording (or replaying) the requests for the test. When recording, the values generated for this
+// test are stored alongside the recording and returned as-is - when replaying, the values stored in the recording are
+// returned so that the test sends the same requests which were recorded.
+func (r *Recorder) StartTest(t *testing.T, values TestValues) TestValues {
+	return r.startTest(t, recordingNameForTest(t.Name()), values)
+}
+
+func (r *Recorder) startTest(t *testing.T, name string, values TestValues) TestValues {
+	r.lock.Lock()
+	existing, ok := r.recordings[name]
+	r.lock.Unlock()
+	if ok {
+		// BuildTestData can be called multiple times within a single test, which must use the same values
+		return existing.TestValues
+	}
+
+	var recording *Recording
+	if r.mode == ModeReplay {
+		var err error
+		recording, err = loadRecording(r.fileName(name))
+		if err != nil {
+			if os.IsNotExist(err) {
+				t.Fatalf("no recording exists for %q at %q - run this test with `%s=%s` to record one", t.Name(), r.fileName(name), EnvRecordingMode, ModeRecord)
+			}
+			t.Fatalf("loading the recording for %q: %+v", t.Name(), err)
+		}
+		values = recording.TestValues
+	} else {
+		recording = &Recording{
+			TestValues: values,
+		}
+	}
+
+	r.lock.Lock()
+	r.recordings[name] = recording
+	r.matchers = append(r.matchers, matchersForTest(name, values)...)
+	sort.SliceStable(r.matchers, func(i, j int) bool {
+		return len(r.matchers[i].value) > len(r.matchers[j].value)
+	})
+	r.lock.Unlock()
+
+	t.Cleanup(func() {
+		r.finishTest(t, name)
+	})
+
+	return values
+}
+
+// finishTest stops attributing requests to the test, saving the recording if the test passed
+func (r *Recorder) finishTest(t *testing.T, name string) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	matchers := make([]matcher, 0)
+	for _, m := range r.matchers {
+		if m.recordingName != name {
+			matchers = append(matchers, m)
+		}
+	}
+	r.matchers = matchers
+
+	recording := r.recordings[name]
+	delete(r.recordings, name)
+
+	if r.mode != ModeRecord {
+		return
+	}
+
+	if t.Failed() {
+		log.Printf("[DEBUG] Recording: not saving the recording for %q since the test failed", t.Name())
+		return
+	}
+
+	if err := recording.save(r.fileName(name)); err != nil {
+		t.Errorf("saving the recording for %q: %+v", t.Name(), err)
+	}
+	if err := r.recordings[sharedRecordingName].save(r.fileName(sharedRecordingName)); err != nil {
+		t.Errorf("saving the shared recording: %+v", err)
+	}
+}
+
+// PrepareRequest is called before each request is sent. When recording, the request body is buffered so that it can
+// be recorded alongside the response - when replaying, the request is redirected to the recorded response.
+func (r *Recorder) PrepareRequest(request *http.Request) (*http.Request, error) {
+	if r.mode == ModeRecord {
+		r.learnReplacementsFromAuthorizationHeader(request.Header.Get("Authorization"))
+
+		if request.Body != nil && request.Body != http.NoBody {
+			body, err := io.ReadAll(request.Body)
+			if err != nil {
+				return nil, fmt.Errorf("reading request body: %+v", err)
+			}
+			request.Body.Close()
+
+			request.Body = io.NopCloser(bytes.NewReader(body))
+			request.GetBody = func() (io.ReadCloser, error) {
+				return io.NopCloser(bytes.NewReader(body)), nil
+			}
+		}
+
+		return request, nil
+	}
+
+	uri := r.sanitise(request.URL.String())
+
+	r.lock.Lock()
+	name := r.recordingNameForURI(uri)
+	index := r.recordings[name].nextInteraction(request.Method, uri)
+	if index == -1 && name != sharedRecordingName {
+		// requests made on behalf of a test can also have been attributed to the shared recording
+		name = sharedRecordingName
+		index = r.recordings[name].nextInteraction(request.Method, uri)
+	}
+	r.lock.Unlock()
+
+	if index == -1 {
+		return nil, fmt.Errorf("no recorded response was found for %s %s", request.Method, uri)
+	}
+
+	replayURI := fmt.Sprintf("%s/%s/%d", r.server.URL, name, index)
+	// the original request is returned in the response, since this is used when polling Long Running Operations
+	ctx := context.WithValue(request.Context(), originalRequestKey{}, request)
+	replayRequest, err := http.NewRequestWithContext(ctx, request.Method, replayURI, nil)
+	if err != nil {
+		return nil, fmt.Errorf("building replay request for %s %s: %+v", request.Method, uri, err)
+	}
+	replayRequest.Header = request.Header.Clone()
+
+	return replayRequest, nil
+}
+
+// RecordResponse is called once the response has been received, and records the request/response when recording
+func (r *Recorder) RecordResponse(request *http.Request, response *http.Response) (*http.Response, error) {
+	if response == nil {
+		return response, nil
+	}
+
+	if r.mode == ModeReplay {
+		if original, ok := request.Context().Value(originalRequestKey{}).(*http.Request); ok {
+			response.Request = original
+		}
+		return response, nil
+	}
+
+	interaction := Interaction{
+		Request: Request{
+			Method: request.Method,
+			URI:    r.sanitise(request.URL.String()),
+		},
+		Response: Response{
+			StatusCode: response.StatusCode,
+			Headers:    make(map[string]string),
+		},
+	}
+
+	if request.GetBody != nil {
+		body, err := request.GetBody()
+		if err != nil {
+			return nil, fmt.Errorf("retrieving request body: %+v", err)
+		}
+		b, err := io.ReadAll(body)
+		if err != nil {
+			return nil, fmt.Errorf("reading request body: %+v", err)
+		}
+		interaction.Request.Body = r.sanitise(string(b))
+	}
+
+	if response.Body != nil {
+		b, err := io.ReadAll(response.Body)
+		if err != nil {
+			return nil, fmt.Errorf("reading response body: %+v", err)
+		}
+		response.Body.Close()
+		response.Body = io.NopCloser(bytes.NewReader(b))
+		interaction.Response.Body = r.sanitise(string(b))
+	}
+
+	for _, header := range recordedResponseHeaders {
+		if v := response.Header.Get(header); v != "" {
+			interaction.Response.Headers[header] = r.sanitise(v)
+		}
+	}
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	recording := r.recordings[r.recordingNameForURI(interaction.Request.URI)]
+	recording.Interactions = append(recording.Interactions, &interaction)
+
+	return response, nil
+}
+
+// serveRecordedResponse writes the recorded response for the request, in the format `/{recordingName}/{index}`
+func (r *Recorder) serveRecordedResponse(w http.ResponseWriter, request *http.Request) {
+	segments := strings.Split(strings.Trim(request.URL.Path, "/"), "/")
+	if len(segments) != 2 {
+		http.Error(w, fmt.Sprintf("unexpected replay request %q", request.URL.Path), http.StatusBadRequest)
+		return
+	}
+
+	index, err := strconv.Atoi(segments[1])
+	if err != nil {
+		http.Error(w, fmt.Sprintf("unexpected replay request %q", request.URL.Path), http.StatusBadRequest)
+		return
+	}
+
+	r.lock.Lock()
+	var interaction *Interaction
+	if recording, ok := r.recordings[segments[0]]; ok && index < len(recording.Interactions) {
+		interaction = recording.Interactions[index]
+	}
+	r.lock.Unlock()
+
+	if interaction == nil {
+		http.Error(w, fmt.Sprintf("no recorded response for %q", request.URL.Path), http.StatusNotFound)
+		return
+	}
+
+	for k, v := range interaction.Response.Headers {
+		w.Header().Set(k, v)
+	}
+	if _, ok := interaction.Response.Headers["Retry-After"]; ok {
+		// there's no need to wait between polling requests when replaying
+		w.Header().Set("Retry-After", "0")
+	}
+	w.WriteHeader(interaction.Response.StatusCode)
+	w.Write([]byte(interaction.Response.Body)) //nolint:errcheck
+}
+
+// recordingNameForURI returns the name of the recording which the request belongs to, the lock must be held by the caller
+func (r *Recorder) recordingNameForURI(uri string) string {
+	for _, m := range r.matchers {
+		if strings.Contains(uri, m.value) {
+			return m.recordingName
+		}
+	}
+
+	return sharedRecordingName
+}
+
+func (r *Recorder) fileName(recordingName string) string {
+	return filepath.Join(r.path, fmt.Sprintf("%s.json", recordingName))
+}
+
+// learnReplacementsFromAuthorizationHeader sanitises the Tenant, Client and Object IDs from the claims in the access
+// token, since these aren't necessarily available from the Environment Variables (for example the Object ID)
+func (r *Recorder) learnReplacementsFromAuthorizationHeader(value string) {
+	if !strings.HasPrefix(value, "Bearer ") {
+		return
+	}
+
+	tokenClaims, err := claims.ParseClaims(&oauth2.Token{AccessToken: strings.TrimPrefix(value, "Bearer ")})
+	if err != nil {
+		return
+	}
+
+	r.addReplacement(tokenClaims.TenantId, placeholderTenantId)
+	r.addReplacement(tokenClaims.AppId, placeholderClientId)
+	r.addReplacement(tokenClaims.ObjectId, placeholderObjectId)
+}
+
+func (r *Recorder) addReplacement(value, placeholder string) {
+	if value == "" || placeholder == "" {
+		return
+	}
+
+	r.lock.Lock()
+	r.replacements[strings.ToLower(value)] = placeholder
+	r.lock.Unlock()
+}
+
+// recordingNameForTest returns the name of the recording for the test, which is used as the file name
+func recordingNameForTest(testName string) string {
+	return strings.NewReplacer("/", "_", "\\", "_", " ", "_").Replace(testName)
+}
+
+// matchersForTest returns the values used to attribute requests to the test - the RandomInteger and the values derived
+// from it by TestData.RandomIntOfLength, and the RandomString
+func matchersForTest(recordingName string, values TestValues) []matcher {
+	matchers := make([]matcher, 0)
+
+	s := strconv.Itoa(values.RandomInteger)
+	matchers = append(matchers, matcher{value: s, recordingName: recordingName})
+	if len(s) == 18 {
+		for length := 17; length >= 8; length-- {
+			v := s[0:length]
+			if length < 16 {
+				v = s[0:length-2] + s[16:18]
+			}
+			matchers = append(matchers, matcher{value: v, recordingName: recordingName})
+		}
+	}
+
+	if values.RandomString != "" {
+		matchers = append(matchers, matcher{value: values.RandomString, recordingName: recordingName})
+	}
+
+	return matchers
+}
@@ -0,0 +1,177 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package recording
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const (
+	testSubscriptionId = "11111111-2222-3333-4444-555555555555"
+	testObjectId       = "66666666-7777-8888-9999-000000000000"
+)
+
+func TestRecorderRecordAndReplay(t *testing.T) {
+	t.Setenv("ARM_SUBSCRIPTION_ID", testSubscriptionId)
+	path := t.TempDir()
+	values := TestValues{
+		RandomInteger: 241018123456789012,
+		RandomString:  "abcde",
+		Locations:     []string{"westeurope", "northeurope", "eastus"},
+	}
+
+	azure := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("X-Ms-Request-Id", "should-not-be-recorded")
+		fmt.Fprintf(w, `{"id": %q, "properties": {"principalId": %q, "primaryKey": "super-secret"}}`, r.URL.Path, testObjectId)
+	}))
+	uri := fmt.Sprintf("%s/subscriptions/%s/resourceGroups/acctestRG-%d?api-version=2020-06-01", azure.URL, testSubscriptionId, values.RandomInteger)
+	expectedBody := fmt.Sprintf(`{"id": "/subscriptions/%s/resourceGroups/acctestRG-%d", "properties": {"principalId": %q, "primaryKey": %q}}`, placeholderSubscriptionId, values.RandomInteger, placeholderObjectId, placeholderRedacted)
+
+	recorder, err := NewRecorder(ModeRecord, path)
+	if err != nil {
+		t.Fatalf("building Recorder: %+v", err)
+	}
+	t.Run("record", func(t *testing.T) {
+		if actual := recorder.startTest(t, "TestExample", values); actual.RandomInteger != values.RandomInteger {
+			t.Fatalf("expected the RandomInteger %d but got %d", values.RandomInteger, actual.RandomInteger)
+		}
+
+		body := sendTestRequest(t, recorder, uri)
+		if strings.Contains(body, placeholderRedacted) {
+			t.Fatalf("expected the response returned when recording not to be sanitised but got %q", body)
+		}
+	})
+	azure.Close()
+
+	contents, err := os.ReadFile(filepath.Join(path, "TestExample.json"))
+	if err != nil {
+		t.Fatalf("reading recording: %+v", err)
+	}
+	for _, v := range []string{testSubscriptionId, testObjectId, "super-secret", "should-not-be-recorded"} {
+		if strings.Contains(string(contents), v) {
+			t.Fatalf("expected %q to be sanitised from the recording but got %s", v, string(contents))
+		}
+	}
+
+	recorder, err = NewRecorder(ModeReplay, path)
+	if err != nil {
+		t.Fatalf("building Recorder: %+v", err)
+	}
+	t.Run("replay", func(t *testing.T) {
+		if actual := recorder.startTest(t, "TestExample", TestValues{RandomInteger: 1}); actual.RandomInteger != values.RandomInteger {
+			t.Fatalf("expected the recorded RandomInteger %d but got %d", values.RandomInteger, actual.RandomInteger)
+		}
+
+		if body := sendTestRequest(t, recorder, uri); body != expectedBody {
+			t.Fatalf("expected the replayed response to be %q but got %q", expectedBody, body)
+		}
+
+		request, err := http.NewRequest(http.MethodDelete, uri, nil)
+		if err != nil {
+			t.Fatalf("building request: %+v", err)
+		}
+		if _, err := recorder.PrepareRequest(request); err == nil {
+			t.Fatalf("expected an error for a request which wasn't recorded but didn't get one")
+		}
+	})
+}
+
+func TestMatchersForTest(t *testing.T) {
+	matchers := matchersForTest("TestExample", TestValues{
+		RandomInteger: 241018123456789012,
+		RandomString:  "abcde",
+	})
+
+	expected := []string{
+		"241018123456789012",
+		"24101812345678901",
+		"2410181234567890",
+		"241018123456712",
+		"24101812345612",
+		"241018123412",
+		"2410181212",
+		"abcde",
+	}
+	for _, v := range expected {
+		found := false
+		for _, m := range matchers {
+			if m.value == v {
+				found = true
+			}
+		}
+		if !found {
+			t.Fatalf("expected a matcher for %q", v)
+		}
+	}
+}
+
+func TestRecorderSanitisesClaimsFromAccessToken(t *testing.T) {
+	authorizer := OfflineAuthorizer{
+		TenantId: "11111111-1111-1111-1111-111111111111",
+		ClientId: "22222222-2222-2222-2222-222222222222",
+		ObjectId: "33333333-3333-3333-3333-333333333333",
+	}
+	token, err := authorizer.Token(context.Background(), &http.Request{})
+	if err != nil {
+		t.Fatalf("retrieving token: %+v", err)
+	}
+
+	recorder, err := NewRecorder(ModeRecord, t.TempDir())
+	if err != nil {
+		t.Fatalf("building Recorder: %+v", err)
+	}
+	recorder.learnReplacementsFromAuthorizationHeader(fmt.Sprintf("Bearer %s", token.AccessToken))
+
+	input := fmt.Sprintf(`{"tenantId": %q, "clientId": %q, "principalId": %q}`, authorizer.TenantId, authorizer.ClientId, strings.ToUpper(authorizer.ObjectId))
+	expected := fmt.Sprintf(`{"tenantId": %q, "clientId": %q, "principalId": %q}`, placeholderTenantId, placeholderClientId, placeholderObjectId)
+	if actual := recorder.sanitise(input); actual != expected {
+		t.Fatalf("expected %q but got %q", expected, actual)
+	}
+}
+
+// sendTestRequest sends a GET request to the uri via the Recorder, returning the response body
+func sendTestRequest(t *testing.T, recorder *Recorder, uri string) string {
+	request, err := http.NewRequest(http.MethodGet, uri, nil)
+	if err != nil {
+		t.Fatalf("building request: %+v", err)
+	}
+	claims := base64.RawURLEncoding.EncodeToString([]byte(fmt.Sprintf(`{"oid": %q}`, testObjectId)))
+	request.Header.Set("Authorization", fmt.Sprintf("Bearer e30.%s.", claims))
+
+	request, err = recorder.PrepareRequest(request)
+	if err != nil {
+		t.Fatalf("preparing request: %+v", err)
+	}
+
+	response, err := http.DefaultClient.Do(request)
+	if err != nil {
+		t.Fatalf("sending request: %+v", err)
+	}
+	defer response.Body.Close()
+
+	response, err = recorder.RecordResponse(request, response)
+	if err != nil {
+		t.Fatalf("recording response: %+v", err)
+	}
+	if response.Request.URL.String() != uri {
+		t.Fatalf("expected the response to be for the request %q but got %q", uri, response.Request.URL.String())
+	}
+
+	body, err := io.ReadAll(response.Body)
+	if err != nil {
+		t.Fatalf("reading response body: %+v", err)
+	}
+
+	return string(body)
+}
@@ -0,0 +1,89 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package recording
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// Recording is the set of requests and responses recorded for a single test
+type Recording struct {
+	TestValues
+
+	Interactions []*Interaction `json:"interactions"`
+}
+
+type Interaction struct {
+	Request  Request  `json:"request"`
+	Response Response `json:"response"`
+
+	// replayed specifies whether this Interaction has already been replayed
+	replayed bool
+}
+
+type Request struct {
+	Method string `json:"method"`
+	URI    string `json:"uri"`
+	Body   string `json:"body,omitempty"`
+}
+
+type Response struct {
+	StatusCode int               `json:"status_code"`
+	Headers    map[string]string `json:"headers,omitempty"`
+	Body       string            `json:"body,omitempty"`
+}
+
+// nextInteraction returns the index of the next Interaction which hasn't been replayed for this request, falling back
+// to the last matching Interaction once all have been replayed - or -1 if there's no matching Interaction
+func (r *Recording) nextInteraction(method, uri string) int {
+	last := -1
+	for i, interaction := range r.Interactions {
+		if interaction.Request.Method != method || interaction.Request.URI != uri {
+			continue
+		}
+
+		if !interaction.replayed {
+			interaction.replayed = true
+			return i
+		}
+
+		last = i
+	}
+
+	return last
+}
+
+func loadRecording(fileName string) (*Recording, error) {
+	contents, err := os.ReadFile(fileName)
+	if err != nil {
+		return nil, err
+	}
+
+	var recording Recording
+	if err := json.Unmarshal(contents, &recording); err != nil {
+		return nil, fmt.Errorf("parsing recording %q: %+v", fileName, err)
+	}
+
+	return &recording, nil
+}
+
+func (r *Recording) save(fileName string) error {
+	contents, err := json.MarshalIndent(r, "", "  ")
+	if err != nil {
+		return fmt.Errorf("serializing recording: %+v", err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(fileName), 0o755); err != nil {
+		return fmt.Errorf("creating directory for recording %q: %+v", fileName, err)
+	}
+
+	if err := os.WriteFile(fileName, contents, 0o644); err != nil {
+		return fmt.Errorf("writing recording %q: %+v", fileName, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,76 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package recording
+
+import (
+	"regexp"
+	"strings"
+)
+
+const (
+	placeholderSubscriptionId          = "00000000-0000-0000-0000-000000000000"
+	placeholderAlternateSubscriptionId = "00000000-0000-0000-0000-000000000001"
+	placeholderTenantId                = "00000000-0000-0000-0000-000000000002"
+	placeholderClientId                = "00000000-0000-0000-0000-000000000003"
+	placeholderObjectId                = "00000000-0000-0000-0000-000000000004"
+	placeholderRedacted                = "REDACTED"
+)
+
+// placeholders are the values used in place of the values of the Environment Variables within the recordings
+var placeholders = map[string]string{
+	"ARM_SUBSCRIPTION_ID":          placeholderSubscriptionId,
+	"ARM_TEST_SUBSCRIPTION_ID_ALT": placeholderAlternateSubscriptionId,
+	"ARM_TENANT_ID":                placeholderTenantId,
+	"ARM_CLIENT_ID":                placeholderClientId,
+	"ARM_CLIENT_SECRET":            placeholderRedacted,
+}
+
+// recordedResponseHeaders are the response headers which are recorded, since these are used when polling
+// Long Running Operations - all other headers are discarded
+var recordedResponseHeaders = []string{
+	"Azure-AsyncOperation",
+	"Content-Type",
+	"Location",
+	"Operation-Location",
+	"Retry-After",
+}
+
+// sensitivePropertiesPattern matches the JSON properties containing credentials which are returned by some APIs,
+// for example when listing the Access Keys for a Storage Account
+var sensitivePropertiesPattern = regexp.MustCompile(`(?i)"(` + strings.Join([]string{
+	"accessKey",
+	"connectionString",
+	"password",
+	"primaryConnectionString",
+	"primaryKey",
+	"primaryMasterKey",
+	"primaryReadonlyMasterKey",
+	"secondaryConnectionString",
+	"secondaryKey",
+	"secondaryMasterKey",
+	"secondaryReadonlyMasterKey",
+	"sharedAccessKey",
+}, "|") + `)"(\s*):(\s*)"[^"]*"`)
+
+// sanitise replaces the Subscription, Tenant, Client and Object IDs with placeholder values and redacts any credentials
+func (r *Recorder) sanitise(input string) string {
+	if input == "" {
+		return input
+	}
+
+	r.lock.Lock()
+	replacements := make([]string, 0, len(r.replacements)*2)
+	for value, placeholder := range r.replacements {
+		replacements = append(replacements, value, placeholder)
+	}
+	r.lock.Unlock()
+
+	output := input
+	for i := 0; i < len(replacements); i += 2 {
+		pattern := regexp.MustCompile("(?i)" + regexp.QuoteMeta(replacements[i]))
+		output = pattern.ReplaceAllLiteralString(output, replacements[i+1])
+	}
+
+	return sensitivePropertiesPattern.ReplaceAllString(output, `"$1"$2:$3"`+placeholderRedacted+`"`)
+}
@@ -42,13 +42,13 @@ func TestAccEnsureRequiredResourceProvidersAreRegistered(t *testing.T) {
 	requiredResourceProviders := resourceproviders.Legacy()
 	subscriptionId := commonids.NewSubscriptionID(armClient.Account.SubscriptionId)
 
-	if err = resourceproviders.EnsureRegistered(ctx, client, subscriptionId, requiredResourceProviders); err != nil {
+	if err = resourceproviders.EnsureRegistered(ctx, client, subscriptionId, requiredResourceProviders, nil); err != nil {
 		t.Fatalf("Error registering Resource Providers: %+v", err)
 	}
 
 	// refresh the cache now things have been re-registered
 	resourceproviders.ClearCache()
-	if err := resourceproviders.CacheSupportedProviders(ctx, client, subscriptionId); err != nil {
+	if err := resourceproviders.CacheSupportedProviders(ctx, client, subscriptionId, nil); err != nil {
 		t.Fatalf("re-caching Resource Providers: %+v", err)
 	}
 
@@ -4,16 +4,22 @@
 package acceptance
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-testing/echoprovider"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/helpers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/recording"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/testclient"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/types"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/provider"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/provider/framework"
 )
 
 func (td TestData) DataSourceTest(t *testing.T, steps []TestStep) {
@@ -42,6 +48,20 @@ func (td TestData) DataSourceTestInSequence(t *testing.T, steps []TestStep) {
 }
 
 func (td TestData) ResourceTest(t *testing.T, testResource types.TestResource, steps []TestStep) {
+	td.runAcceptanceTest(t, td.resourceTestCase(t, testResource, steps))
+}
+
+// ResourceTestWriteOnly runs the test for a resource using Write-Only arguments, which are only supported
+// by Terraform 1.11 and later - as such this is skipped when using older versions of Terraform
+func (td TestData) ResourceTestWriteOnly(t *testing.T, testResource types.TestResource, steps []TestStep) {
+	testCase := td.resourceTestCase(t, testResource, steps)
+	testCase.TerraformVersionChecks = []tfversion.TerraformVersionCheck{
+		tfversion.SkipBelow(tfversion.Version1_11_0),
+	}
+	td.runAcceptanceTest(t, testCase)
+}
+
+func (td TestData) resourceTestCase(t *testing.T, testResource types.TestResource, steps []TestStep) resource.TestCase {
 	// Testing framework as of 1.6.0 no longer auto-refreshes state, so adding it back in here for all steps that update
 	// the config rather than having to modify 1000's of tests individually to add a refresh-only step
 	var refreshStep = TestStep{
@@ -70,7 +90,7 @@ func (td TestData) ResourceTest(t *testing.T, testResource types.TestResource, s
 		},
 		Steps: steps,
 	}
-	td.runAcceptanceTest(t, testCase)
+	return testCase
 }
 
 // ResourceTestIgnoreCheckDestroyed skips the check to confirm the resource test has been destroyed.
@@ -109,6 +129,30 @@ func (td TestData) ResourceSequentialTest(t *testing.T, testResource types.TestR
 	td.runAcceptanceSequentialTest(t, testCase)
 }
 
+// EphemeralResourceTest runs the test using the Plugin Framework Provider muxed with the Plugin SDKv2 Provider, since
+// Ephemeral Resources are exposed through the Plugin Framework. Values are never persisted to the state, as such the
+// `echo` provider is made available so that the values of the Ephemeral Resource can be checked.
+func (td TestData) EphemeralResourceTest(t *testing.T, steps []TestStep) {
+	// Ephemeral Resources don't need a check destroy - however since this is a wrapper function
+	// and not matching the ignore pattern `XXX_data_source_test.go`, this needs to be explicitly opted out
+
+	//lintignore:AT001
+	testCase := resource.TestCase{
+		PreCheck: func() { PreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		ExternalProviders:        td.externalProviders(),
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"echo": echoprovider.NewProviderServer(),
+		},
+		Steps: steps,
+	}
+
+	resource.ParallelTest(t, testCase)
+}
+
 func RunTestsInSequence(t *testing.T, tests map[string]map[string]func(t *testing.T)) {
 	for group, m := range tests {
 		m := m
@@ -140,16 +184,26 @@ func (td TestData) runAcceptanceSequentialTest(t *testing.T, testCase resource.T
 func (td TestData) providers() map[string]func() (*schema.Provider, error) {
 	return map[string]func() (*schema.Provider, error){
 		"azurerm": func() (*schema.Provider, error) { //nolint:unparam
-			azurerm := provider.TestAzureProvider()
+			azurerm := testAzureProvider()
 			return azurerm, nil
 		},
 		"azurerm-alt": func() (*schema.Provider, error) { //nolint:unparam
-			azurerm := provider.TestAzureProvider()
+			azurerm := testAzureProvider()
 			return azurerm, nil
 		},
 	}
 }
 
+// testAzureProvider returns the Provider used in the Acceptance Tests, which records (or replays) all requests when
+// the `ARM_TEST_RECORDING_MODE` Environment Variable is set
+func testAzureProvider() *schema.Provider {
+	if recorder := recording.Current(); recorder != nil {
+		return provider.TestAzureProviderWithHTTPRecorder(recorder, recorder.Authorizer())
+	}
+
+	return provider.TestAzureProvider()
+}
+
 func (td TestData) externalProviders() map[string]resource.ExternalProvider {
 	return map[string]resource.ExternalProvider{
 		"azuread": {
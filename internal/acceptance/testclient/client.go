@@ -11,6 +11,7 @@ import (
 
 	"github.com/hashicorp/go-azure-sdk/sdk/auth"
 	"github.com/hashicorp/go-azure-sdk/sdk/environments"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/recording"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
 )
@@ -72,6 +73,11 @@ func Build() (*clients.Client, error) {
 			SubscriptionID:    os.Getenv("ARM_SUBSCRIPTION_ID"),
 		}
 
+		if recorder := recording.Current(); recorder != nil {
+			clientBuilder.Authorizer = recorder.Authorizer()
+			clientBuilder.HTTPRecorder = recorder
+		}
+
 		client, err := clients.Build(ctx, clientBuilder)
 		if err != nil {
 			return nil, fmt.Errorf("building test client: %+v", err)
@@ -82,3 +88,25 @@ func Build() (*clients.Client, error) {
 
 	return _client, nil
 }
+
+// BuildForEnvironment returns a Client which sends requests to the specified Environment, authenticating using the
+// specified Authorizer - for example to send requests to a fake Resource Manager (see the `fakearm` package). Unlike
+// Build this Client isn't cached, since each fake Resource Manager is only used by a single test.
+func BuildForEnvironment(ctx context.Context, env environments.Environment, subscriptionId string, authorizer auth.Authorizer) (*clients.Client, error) {
+	clientBuilder := clients.ClientBuilder{
+		AuthConfig: &auth.Credentials{
+			Environment: env,
+		},
+		Authorizer:       authorizer,
+		TerraformVersion: os.Getenv("TERRAFORM_CORE_VERSION"),
+		Features:         features.Default(),
+		SubscriptionID:   subscriptionId,
+	}
+
+	client, err := clients.Build(ctx, clientBuilder)
+	if err != nil {
+		return nil, fmt.Errorf("building test client: %+v", err)
+	}
+
+	return client, nil
+}
@@ -13,9 +13,15 @@ import (
 	"github.com/hashicorp/go-azure-sdk/sdk/auth"
 	"github.com/hashicorp/go-azure-sdk/sdk/environments"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/recording"
 )
 
 func PreCheck(t *testing.T) {
+	// the credentials aren't used and the locations are restored from the recordings when replaying
+	if recording.Current().Replaying() {
+		return
+	}
+
 	variables := []string{
 		"ARM_CLIENT_ID",
 		"ARM_CLIENT_SECRET",
@@ -36,6 +36,11 @@ func NewResourceManagerAccount(ctx context.Context, config auth.Credentials, sub
 		return nil, fmt.Errorf("unable to build authorizer for Microsoft Graph API: %+v", err)
 	}
 
+	return newResourceManagerAccount(ctx, authorizer, config, subscriptionId, registeredResourceProviders)
+}
+
+// newResourceManagerAccount builds the ResourceManagerAccount using the claims from an access token for Microsoft Graph
+func newResourceManagerAccount(ctx context.Context, authorizer auth.Authorizer, config auth.Credentials, subscriptionId string, registeredResourceProviders resourceproviders.ResourceProviders) (*ResourceManagerAccount, error) {
 	// Acquire an access token so we can inspect the claims
 	token, err := authorizer.Token(ctx, &http.Request{})
 	if err != nil {
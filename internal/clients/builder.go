@@ -15,25 +15,90 @@ import (
 	authWrapper "github.com/hashicorp/go-azure-sdk/sdk/auth/autorest"
 	"github.com/hashicorp/go-azure-sdk/sdk/environments"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/diskcache"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/resourceproviders"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tracing"
+	"github.com/hashicorp/terraform-provider-azurerm/version"
 )
 
 type ClientBuilder struct {
 	AuthConfig *auth.Credentials
 	Features   features.UserFeatures
 
+	// Authorizer is used to authenticate all requests in place of the Authorizers built from the AuthConfig when
+	// specified, for example when replaying recorded requests in the Acceptance Tests
+	Authorizer auth.Authorizer
+
+	// HTTPRecorder records (or replays) all requests when specified, and is only used by the Acceptance Tests
+	HTTPRecorder common.HTTPRecorder
+
+	// DefaultTimeouts overrides the default timeouts used by Resources, see timeouts.DefaultTimeoutsForResourceType
+	DefaultTimeouts []timeouts.DefaultTimeouts
+
+	// DiskCache enables persisting the results of expensive List operations to disk when specified
+	DiskCache *DiskCache
+
+	// StructuredLogging logs a single line of JSON for each request, with any sensitive fields redacted, when specified
+	StructuredLogging *StructuredLogging
+
+	// Tracing enables exporting OpenTelemetry spans for each operation and request when specified
+	Tracing *Tracing
+
 	CustomCorrelationRequestID  string
 	DisableCorrelationRequestID bool
 	DisableTerraformPartnerID   bool
 	MetadataHost                string
 	PartnerID                   string
 	RegisteredResourceProviders resourceproviders.ResourceProviders
+	RequestThrottling           *RequestThrottling
 	StorageUseAzureAD           bool
 	SubscriptionID              string
 	TerraformVersion            string
 }
 
+const (
+	DefaultRequestThrottlingRemainingRequestsThreshold    = 100
+	DefaultRequestThrottlingRequestIntervalInMilliseconds = 500
+)
+
+const DefaultDiskCacheTimeToLiveInMinutes = 60
+
+// DiskCache configures the cache used to persist the results of expensive List operations, see diskcache.Cache
+type DiskCache struct {
+	// Directory is the directory in which the cached items are stored
+	Directory string
+
+	// TimeToLive is the duration after which cached items expire
+	TimeToLive time.Duration
+}
+
+// RequestThrottling configures the throttling of requests to Resource Manager, see common.RequestThrottler
+type RequestThrottling struct {
+	// RemainingRequestsThreshold is the number of remaining requests below which requests are queued
+	RemainingRequestsThreshold int
+
+	// RequestInterval is the interval at which queued requests are sent
+	RequestInterval time.Duration
+}
+
+// StructuredLogging configures the logging of requests as JSON, see common.HTTPLogger
+type StructuredLogging struct {
+	// RedactedFields is a list of additional field names whose values are redacted, matching the end of the field name
+	RedactedFields []string
+
+	// SensitiveFields is a list of the attributes which are always Sensitive within the Provider's schema, whose values are redacted
+	SensitiveFields []string
+}
+
+// Tracing configures the export of OpenTelemetry spans, see tracing.Tracer
+type Tracing struct {
+	// Endpoint is the URL of the OTLP/HTTP endpoint spans are exported to, when empty this is sourced from the
+	// standard `OTEL_EXPORTER_OTLP_*` environment variables
+	Endpoint string
+}
+
 const azureStackEnvironmentError = `
 The AzureRM Provider supports the different Azure Public Clouds - including China, Public,
 and US Government - however it does not support Azure Stack due to differences in API and
@@ -53,23 +118,31 @@ func Build(ctx context.Context, builder ClientBuilder) (*Client, error) {
 
 	var resourceManagerAuth, storageAuth, synapseAuth, batchManagementAuth, keyVaultAuth auth.Authorizer
 
-	resourceManagerAuth, err = auth.NewAuthorizerFromCredentials(ctx, *builder.AuthConfig, builder.AuthConfig.Environment.ResourceManager)
+	newAuthorizer := func(api environments.Api) (auth.Authorizer, error) {
+		if builder.Authorizer != nil {
+			return builder.Authorizer, nil
+		}
+
+		return auth.NewAuthorizerFromCredentials(ctx, *builder.AuthConfig, api)
+	}
+
+	resourceManagerAuth, err = newAuthorizer(builder.AuthConfig.Environment.ResourceManager)
 	if err != nil {
 		return nil, fmt.Errorf("unable to build authorizer for Resource Manager API: %+v", err)
 	}
 
-	storageAuth, err = auth.NewAuthorizerFromCredentials(ctx, *builder.AuthConfig, builder.AuthConfig.Environment.Storage)
+	storageAuth, err = newAuthorizer(builder.AuthConfig.Environment.Storage)
 	if err != nil {
 		return nil, fmt.Errorf("unable to build authorizer for Storage API: %+v", err)
 	}
 
-	keyVaultAuth, err = auth.NewAuthorizerFromCredentials(ctx, *builder.AuthConfig, builder.AuthConfig.Environment.KeyVault)
+	keyVaultAuth, err = newAuthorizer(builder.AuthConfig.Environment.KeyVault)
 	if err != nil {
 		return nil, fmt.Errorf("unable to build authorizer for Key Vault API: %+v", err)
 	}
 
 	if builder.AuthConfig.Environment.Synapse.Available() {
-		synapseAuth, err = auth.NewAuthorizerFromCredentials(ctx, *builder.AuthConfig, builder.AuthConfig.Environment.Synapse)
+		synapseAuth, err = newAuthorizer(builder.AuthConfig.Environment.Synapse)
 		if err != nil {
 			return nil, fmt.Errorf("unable to build authorizer for Synapse API: %+v", err)
 		}
@@ -78,7 +151,7 @@ func Build(ctx context.Context, builder ClientBuilder) (*Client, error) {
 	}
 
 	if builder.AuthConfig.Environment.Batch.Available() {
-		batchManagementAuth, err = auth.NewAuthorizerFromCredentials(ctx, *builder.AuthConfig, builder.AuthConfig.Environment.Batch)
+		batchManagementAuth, err = newAuthorizer(builder.AuthConfig.Environment.Batch)
 		if err != nil {
 			return nil, fmt.Errorf("unable to build authorizer for Batch Management API: %+v", err)
 		}
@@ -88,7 +161,7 @@ func Build(ctx context.Context, builder ClientBuilder) (*Client, error) {
 
 	// Helper for obtaining endpoint-specific tokens
 	authorizerFunc := common.ApiAuthorizerFunc(func(api environments.Api) (auth.Authorizer, error) {
-		authorizer, err := auth.NewAuthorizerFromCredentials(ctx, *builder.AuthConfig, api)
+		authorizer, err := newAuthorizer(api)
 		if err != nil {
 			return nil, fmt.Errorf("building custom authorizer for API %q: %+v", api.Name(), err)
 		}
@@ -96,14 +169,19 @@ func Build(ctx context.Context, builder ClientBuilder) (*Client, error) {
 		return authorizer, nil
 	})
 
-	account, err := NewResourceManagerAccount(ctx, *builder.AuthConfig, builder.SubscriptionID, builder.RegisteredResourceProviders)
+	graphAuth, err := newAuthorizer(builder.AuthConfig.Environment.MicrosoftGraph)
+	if err != nil {
+		return nil, fmt.Errorf("unable to build authorizer for Microsoft Graph API: %+v", err)
+	}
+
+	account, err := newResourceManagerAccount(ctx, graphAuth, *builder.AuthConfig, builder.SubscriptionID, builder.RegisteredResourceProviders)
 	if err != nil {
 		return nil, fmt.Errorf("building account: %+v", err)
 	}
 
 	var managedHSMAuth auth.Authorizer
 	if builder.AuthConfig.Environment.ManagedHSM.Available() {
-		managedHSMAuth, err = auth.NewAuthorizerFromCredentials(ctx, *builder.AuthConfig, builder.AuthConfig.Environment.ManagedHSM)
+		managedHSMAuth, err = newAuthorizer(builder.AuthConfig.Environment.ManagedHSM)
 		if err != nil {
 			return nil, fmt.Errorf("unable to build authorizer for Managed HSM API: %+v", err)
 		}
@@ -149,16 +227,41 @@ func Build(ctx context.Context, builder ClientBuilder) (*Client, error) {
 		CustomCorrelationRequestID:  builder.CustomCorrelationRequestID,
 		DisableCorrelationRequestID: builder.DisableCorrelationRequestID,
 		DisableTerraformPartnerID:   builder.DisableTerraformPartnerID,
+		HTTPRecorder:                builder.HTTPRecorder,
 		SkipProviderReg:             len(builder.RegisteredResourceProviders) == 0,
 		StorageUseAzureAD:           builder.StorageUseAzureAD,
 
 		ResourceManagerEndpoint: *resourceManagerEndpoint,
 	}
 
+	if v := builder.RequestThrottling; v != nil {
+		o.RequestThrottler = common.NewRequestThrottler(v.RemainingRequestsThreshold, v.RequestInterval)
+	}
+
+	if v := builder.StructuredLogging; v != nil {
+		o.HTTPLogger = common.NewHTTPLogger("AzureRM", v.RedactedFields, v.SensitiveFields)
+	}
+
+	if v := builder.Tracing; v != nil {
+		tracer, err := tracing.NewTracer(ctx, v.Endpoint, version.ProviderVersion)
+		if err != nil {
+			return nil, fmt.Errorf("configuring tracing: %+v", err)
+		}
+		o.Tracer = tracer
+	}
+
+	if v := builder.DiskCache; v != nil {
+		o.DiskCache = diskcache.New(v.Directory, account.TenantId, v.TimeToLive)
+	}
+
 	if err := client.Build(ctx, o); err != nil {
 		return nil, fmt.Errorf("building Client: %+v", err)
 	}
 
+	client.DefaultTimeouts = builder.DefaultTimeouts
+	client.DiskCache = o.DiskCache
+	client.Tracer = o.Tracer
+
 	if features.EnhancedValidationEnabled() {
 		subscriptionId := commonids.NewSubscriptionID(client.Account.SubscriptionId)
 
@@ -166,7 +269,7 @@ func Build(ctx context.Context, builder ClientBuilder) (*Client, error) {
 		defer cancel()
 
 		location.CacheSupportedLocations(ctx2, *resourceManagerEndpoint)
-		if err := resourceproviders.CacheSupportedProviders(ctx2, client.Resource.ResourceProvidersClient, subscriptionId); err != nil {
+		if err := resourceproviders.CacheSupportedProviders(ctx2, client.Resource.ResourceProvidersClient, subscriptionId, client.DiskCache); err != nil {
 			log.Printf("[DEBUG] error retrieving providers: %s. Enhanced validation will be unavailable", err)
 		}
 	}
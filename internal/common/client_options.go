@@ -5,6 +5,7 @@ package common
 
 import (
 	"fmt"
+	"net/http"
 	"os"
 	"strings"
 
@@ -13,7 +14,9 @@ import (
 	"github.com/hashicorp/go-azure-sdk/sdk/auth"
 	"github.com/hashicorp/go-azure-sdk/sdk/client"
 	"github.com/hashicorp/go-azure-sdk/sdk/environments"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/diskcache"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tracing"
 	"github.com/hashicorp/terraform-provider-azurerm/version"
 )
 
@@ -31,6 +34,15 @@ type Authorizers struct {
 
 type ApiAuthorizerFunc func(api environments.Api) (auth.Authorizer, error)
 
+// HTTPRecorder records the requests sent to Azure and the responses returned, or replays the recorded responses
+type HTTPRecorder interface {
+	// PrepareRequest is called immediately before the request is sent, and returns the request which should be sent
+	PrepareRequest(request *http.Request) (*http.Request, error)
+
+	// RecordResponse is called once the response has been received, and returns the response which should be used
+	RecordResponse(request *http.Request, response *http.Response) (*http.Response, error)
+}
+
 type ClientOptions struct {
 	Authorizers *Authorizers
 	AuthConfig  *auth.Credentials
@@ -48,6 +60,25 @@ type ClientOptions struct {
 	DisableTerraformPartnerID bool
 	StorageUseAzureAD         bool
 
+	// RequestThrottler is shared between all clients, to limit the rate of requests to Resource Manager
+	// per Subscription and Resource Provider - this is nil when request throttling isn't enabled
+	RequestThrottler *RequestThrottler
+
+	// HTTPRecorder records (or replays) the requests sent by all clients, and is only used by the Acceptance Tests
+	HTTPRecorder HTTPRecorder
+
+	// DiskCache persists the results of expensive List operations between Terraform runs - this is nil when the
+	// disk cache isn't enabled
+	DiskCache *diskcache.Cache
+
+	// HTTPLogger logs a single line of JSON (with any sensitive fields redacted) for each request in place of the
+	// request and response dumps - this is nil when structured logging isn't enabled
+	HTTPLogger *HTTPLogger
+
+	// Tracer emits an OpenTelemetry span for each request sent by go-azure-sdk clients - this is nil when tracing
+	// isn't enabled
+	Tracer *tracing.Tracer
+
 	ResourceManagerEndpoint string
 
 	// Legacy authorizers for go-autorest
@@ -74,8 +105,29 @@ func (o ClientOptions) Configure(c client.BaseClient, authorizer auth.Authorizer
 		c.AppendRequestMiddleware(correlationRequestIDMiddleware(id))
 	}
 
-	c.AppendRequestMiddleware(requestLoggerMiddleware("AzureRM"))
-	c.AppendResponseMiddleware(responseLoggerMiddleware("AzureRM"))
+	if o.RequestThrottler != nil {
+		c.AppendRequestMiddleware(requestThrottlingMiddleware(o.RequestThrottler))
+		c.AppendResponseMiddleware(responseThrottlingMiddleware(o.RequestThrottler))
+	}
+
+	if o.Tracer != nil {
+		c.AppendRequestMiddleware(o.Tracer.PrepareRequest)
+		c.AppendResponseMiddleware(o.Tracer.EndRequest)
+	}
+
+	if o.HTTPLogger != nil {
+		c.AppendRequestMiddleware(o.HTTPLogger.PrepareRequest)
+		c.AppendResponseMiddleware(o.HTTPLogger.LogResponse)
+	} else {
+		c.AppendRequestMiddleware(requestLoggerMiddleware("AzureRM"))
+		c.AppendResponseMiddleware(responseLoggerMiddleware("AzureRM"))
+	}
+
+	// this is configured last so that the request which would have been sent is logged when replaying
+	if o.HTTPRecorder != nil {
+		c.AppendRequestMiddleware(o.HTTPRecorder.PrepareRequest)
+		c.AppendResponseMiddleware(o.HTTPRecorder.RecordResponse)
+	}
 }
 
 // ConfigureClient sets up an autorest.Client using an autorest.Authorizer
@@ -83,7 +135,21 @@ func (o ClientOptions) ConfigureClient(c *autorest.Client, authorizer autorest.A
 	c.UserAgent = userAgent(c.UserAgent, o.TerraformVersion, o.PartnerId, o.DisableTerraformPartnerID)
 
 	c.Authorizer = authorizer
-	c.Sender = sender.BuildSender("AzureRM")
+	if o.HTTPLogger != nil {
+		c.Sender = withHTTPLogger(o.HTTPLogger, &http.Client{
+			Transport: &http.Transport{
+				Proxy: http.ProxyFromEnvironment,
+			},
+		})
+	} else {
+		c.Sender = sender.BuildSender("AzureRM")
+	}
+	if o.RequestThrottler != nil {
+		c.Sender = withRequestThrottling(o.RequestThrottler, c.Sender)
+	}
+	if o.HTTPRecorder != nil {
+		c.Sender = withHTTPRecorder(o.HTTPRecorder, c.Sender)
+	}
 	c.SkipResourceProviderRegistration = o.SkipProviderReg
 	if !o.DisableCorrelationRequestID {
 		id := o.CustomCorrelationRequestID
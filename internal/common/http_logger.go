@@ -0,0 +1,285 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package common
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptrace"
+	"net/url"
+	"strings"
+	"sync/atomic"
+	"time"
+)
+
+// redactedValue replaces the value of any sensitive field which is logged
+const redactedValue = "REDACTED"
+
+// defaultRedactedFields are always redacted by the HTTPLogger, these are matched against the end of the field name,
+// for example `key` matches both `primaryKey` and `account_key`, and `sig` matches the signature of a SAS Token
+var defaultRedactedFields = []string{
+	"password",
+	"secret",
+	"key",
+	"keys",
+	"token",
+	"connectionstring",
+	"connectionstrings",
+	"credential",
+	"credentials",
+	"sig",
+}
+
+// HTTPLogger logs a single line of JSON for each request sent to Azure - containing the method, URL, status code,
+// duration, correlation request ID and the number of times the request was retried, alongside the request and
+// response bodies.
+//
+// Headers are never logged, the values of any query string parameters or JSON fields which are considered sensitive
+// are redacted, and bodies which aren't JSON are omitted - since these can't be redacted.
+type HTTPLogger struct {
+	providerName string
+
+	// redactedFields are redacted when these match the end of the (normalized) field name
+	redactedFields []string
+
+	// sensitiveFields are redacted when these match the (normalized) field name exactly, these are the names of the
+	// Sensitive attributes within the Provider's schema - which are too generic to match the end of a field name
+	sensitiveFields map[string]struct{}
+
+	// logf and now are overridden in tests
+	logf func(format string, v ...interface{})
+	now  func() time.Time
+}
+
+// httpLoggerContextKey is the key used to store the httpLoggerCall for a request within its Context
+type httpLoggerContextKey struct{}
+
+// httpLoggerCall tracks a request sent by the go-azure-sdk base layer, which is retried within the http.Client
+type httpLoggerCall struct {
+	start       time.Time
+	requestBody []byte
+
+	// attempts is incremented each time a connection is obtained to send the request
+	attempts int32
+}
+
+type httpLogEntry struct {
+	Method               string      `json:"method"`
+	URL                  string      `json:"url"`
+	StatusCode           int         `json:"status_code,omitempty"`
+	Error                string      `json:"error,omitempty"`
+	DurationInMS         int64       `json:"duration_ms"`
+	CorrelationRequestID string      `json:"correlation_request_id,omitempty"`
+	RetryCount           int         `json:"retry_count"`
+	RequestBody          interface{} `json:"request_body,omitempty"`
+	ResponseBody         interface{} `json:"response_body,omitempty"`
+}
+
+// NewHTTPLogger returns a HTTPLogger which redacts the default fields, any field ending with one of redactedFields
+// and any field matching one of sensitiveFields - field names are compared case-insensitively, ignoring underscores
+// and hyphens, such that the schema attribute `admin_password` matches the API field `adminPassword`
+func NewHTTPLogger(providerName string, redactedFields []string, sensitiveFields []string) *HTTPLogger {
+	logger := &HTTPLogger{
+		providerName:    providerName,
+		redactedFields:  make([]string, 0),
+		sensitiveFields: make(map[string]struct{}),
+		logf:            log.Printf,
+		now:             time.Now,
+	}
+
+	for _, v := range append(defaultRedactedFields, redactedFields...) {
+		if v := normalizeFieldName(v); v != "" {
+			logger.redactedFields = append(logger.redactedFields, v)
+		}
+	}
+	for _, v := range sensitiveFields {
+		if v := normalizeFieldName(v); v != "" {
+			logger.sensitiveFields[v] = struct{}{}
+		}
+	}
+
+	return logger
+}
+
+// PrepareRequest is a client.RequestMiddleware which records the start time and body of the request, and counts the
+// number of attempts made to send it
+func (l *HTTPLogger) PrepareRequest(request *http.Request) (*http.Request, error) {
+	call := &httpLoggerCall{
+		start: l.now(),
+	}
+
+	body, err := readBody(&request.Body)
+	if err != nil {
+		return nil, err
+	}
+	call.requestBody = body
+
+	trace := &httptrace.ClientTrace{
+		GetConn: func(string) {
+			atomic.AddInt32(&call.attempts, 1)
+		},
+	}
+	ctx := context.WithValue(request.Context(), httpLoggerContextKey{}, call)
+	return request.WithContext(httptrace.WithClientTrace(ctx, trace)), nil
+}
+
+// LogResponse is a client.ResponseMiddleware which logs the request and response
+func (l *HTTPLogger) LogResponse(request *http.Request, response *http.Response) (*http.Response, error) {
+	call, ok := request.Context().Value(httpLoggerContextKey{}).(*httpLoggerCall)
+	if !ok {
+		// the request wasn't prepared by this logger, so we can only log the response
+		call = &httpLoggerCall{
+			start: l.now(),
+		}
+	}
+
+	retries := int(atomic.LoadInt32(&call.attempts)) - 1
+	if retries < 0 {
+		retries = 0
+	}
+
+	return l.log(request, call.start, call.requestBody, retries, response, nil)
+}
+
+// log writes the log entry for the request and response (or error), returning the response with its body restored
+func (l *HTTPLogger) log(request *http.Request, start time.Time, requestBody []byte, retries int, response *http.Response, requestErr error) (*http.Response, error) {
+	entry := httpLogEntry{
+		Method:               request.Method,
+		URL:                  l.redactURL(request.URL),
+		DurationInMS:         l.now().Sub(start).Milliseconds(),
+		CorrelationRequestID: request.Header.Get(HeaderCorrelationRequestID),
+		RetryCount:           retries,
+		RequestBody:          l.redactBody(requestBody),
+	}
+
+	if requestErr != nil {
+		entry.Error = requestErr.Error()
+	}
+
+	if response != nil {
+		entry.StatusCode = response.StatusCode
+		if entry.CorrelationRequestID == "" {
+			entry.CorrelationRequestID = response.Header.Get(HeaderCorrelationRequestID)
+		}
+
+		body, err := readBody(&response.Body)
+		if err != nil {
+			return response, err
+		}
+		entry.ResponseBody = l.redactBody(body)
+	}
+
+	line, err := json.Marshal(entry)
+	if err != nil {
+		l.logf("[DEBUG] %s Request: %s to %s (unable to marshal the log entry: %+v)", l.providerName, entry.Method, entry.URL, err)
+		return response, nil
+	}
+	l.logf("[DEBUG] %s", line)
+
+	return response, nil
+}
+
+// redactURL returns the URL with the values of any sensitive query string parameters redacted
+func (l *HTTPLogger) redactURL(input *url.URL) string {
+	if input == nil {
+		return ""
+	}
+
+	output := *input
+	output.User = nil
+
+	query := output.Query()
+	for key := range query {
+		if l.isSensitive(key) {
+			query[key] = []string{redactedValue}
+		}
+	}
+	output.RawQuery = query.Encode()
+
+	return output.String()
+}
+
+// redactBody returns the JSON body with the values of any sensitive fields redacted, or nil if it's empty or not JSON
+func (l *HTTPLogger) redactBody(input []byte) interface{} {
+	if len(bytes.TrimSpace(input)) == 0 {
+		return nil
+	}
+
+	var body interface{}
+	if err := json.Unmarshal(input, &body); err != nil {
+		return nil
+	}
+
+	return l.redactValue(body, false)
+}
+
+func (l *HTTPLogger) redactValue(input interface{}, sensitive bool) interface{} {
+	switch v := input.(type) {
+	case map[string]interface{}:
+		for key, value := range v {
+			v[key] = l.redactValue(value, l.isSensitive(key))
+		}
+		return v
+
+	case []interface{}:
+		for i, value := range v {
+			v[i] = l.redactValue(value, sensitive)
+		}
+		return v
+
+	case nil:
+		return nil
+
+	default:
+		// only scalar values are redacted, so that the structure of nested objects is retained
+		if sensitive {
+			return redactedValue
+		}
+		return v
+	}
+}
+
+// isSensitive returns whether the value of the field (or query string parameter) should be redacted
+func (l *HTTPLogger) isSensitive(name string) bool {
+	name = normalizeFieldName(name)
+	if name == "" {
+		return false
+	}
+
+	if _, ok := l.sensitiveFields[name]; ok {
+		return true
+	}
+	for _, v := range l.redactedFields {
+		if strings.HasSuffix(name, v) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// normalizeFieldName returns the lower-cased field name without any underscores or hyphens
+func normalizeFieldName(input string) string {
+	return strings.NewReplacer("_", "", "-", "").Replace(strings.ToLower(strings.TrimSpace(input)))
+}
+
+// readBody reads the body, replacing it so that it can be read again
+func readBody(body *io.ReadCloser) ([]byte, error) {
+	if body == nil || *body == nil || *body == http.NoBody {
+		return nil, nil
+	}
+
+	output, err := io.ReadAll(*body)
+	if err != nil {
+		return nil, err
+	}
+	(*body).Close()
+	*body = io.NopCloser(bytes.NewReader(output))
+
+	return output, nil
+}
@@ -0,0 +1,166 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package common
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-retryablehttp"
+)
+
+func TestHTTPLoggerIsSensitive(t *testing.T) {
+	logger := NewHTTPLogger("AzureRM", []string{"custom_field"}, []string{"admin_password", "value"})
+
+	testData := map[string]bool{
+		"adminPassword":               true,
+		"administrator_login":         false,
+		"primaryKey":                  true,
+		"keyVaultId":                  false,
+		"PrimaryConnectionString":     true,
+		"clientSecret":                true,
+		"access-token":                true,
+		"sig":                         true,
+		"value":                       true,
+		"defaultValue":                false,
+		"myCustomField":               true,
+		"customFieldName":             false,
+		"name":                        false,
+		"":                            false,
+		"storageAccountAccessKey":     true,
+		"servicePrincipalCredentials": true,
+	}
+
+	for input, expected := range testData {
+		if actual := logger.isSensitive(input); actual != expected {
+			t.Fatalf("expected %t for %q but got %t", expected, input, actual)
+		}
+	}
+}
+
+func TestHTTPLoggerRedactBody(t *testing.T) {
+	logger := NewHTTPLogger("AzureRM", nil, []string{"value"})
+
+	testData := []struct {
+		Input    string
+		Expected interface{}
+	}{
+		{
+			Input: `{"name": "example", "properties": {"adminPassword": "P@ssw0rd", "keys": [{"name": "key1", "value": "abc"}], "secrets": {"nested": "retained-structure"}, "enabled": true}}`,
+			Expected: map[string]interface{}{
+				"name": "example",
+				"properties": map[string]interface{}{
+					"adminPassword": redactedValue,
+					"keys": []interface{}{
+						map[string]interface{}{"name": "key1", "value": redactedValue},
+					},
+					"secrets": map[string]interface{}{"nested": "retained-structure"},
+					"enabled": true,
+				},
+			},
+		},
+		{
+			Input:    `{"accessKeys": ["abc", "def"]}`,
+			Expected: map[string]interface{}{"accessKeys": []interface{}{redactedValue, redactedValue}},
+		},
+		{
+			Input:    `<?xml version="1.0"?><SignedIdentifiers />`,
+			Expected: nil,
+		},
+		{
+			Input:    "",
+			Expected: nil,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+		if actual := logger.redactBody([]byte(v.Input)); !reflect.DeepEqual(actual, v.Expected) {
+			t.Fatalf("expected %+v but got %+v", v.Expected, actual)
+		}
+	}
+}
+
+func TestHTTPLoggerLogsRetriedRequest(t *testing.T) {
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if body, _ := io.ReadAll(r.Body); string(body) != `{"properties":{"password":"P@ssw0rd"}}` {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		if attempts == 1 {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"name": "example", "primaryConnectionString": "Endpoint=sb://example;SharedAccessKey=abc"}`)
+	}))
+	defer server.Close()
+
+	lines := make([]string, 0)
+	logger := NewHTTPLogger("AzureRM", nil, nil)
+	logger.logf = func(format string, v ...interface{}) {
+		lines = append(lines, fmt.Sprintf(format, v...))
+	}
+
+	retryableClient := retryablehttp.NewClient()
+	retryableClient.Logger = nil
+	retryableClient.RetryWaitMin = time.Millisecond
+	retryableClient.RetryWaitMax = time.Millisecond
+	httpClient := retryableClient.StandardClient()
+
+	request, err := http.NewRequest(http.MethodPut, server.URL+"/example?api-version=2023-01-01&sig=abc123", strings.NewReader(`{"properties":{"password":"P@ssw0rd"}}`))
+	if err != nil {
+		t.Fatalf("building request: %+v", err)
+	}
+	request.Header.Set("Authorization", "Bearer abc123")
+	request.Header.Set(HeaderCorrelationRequestID, "11111111-1111-1111-1111-111111111111")
+
+	request, err = logger.PrepareRequest(request)
+	if err != nil {
+		t.Fatalf("preparing request: %+v", err)
+	}
+	response, err := httpClient.Do(request)
+	if err != nil {
+		t.Fatalf("sending request: %+v", err)
+	}
+	response, err = logger.LogResponse(request, response)
+	if err != nil {
+		t.Fatalf("logging response: %+v", err)
+	}
+
+	// the response body should still be readable once logged
+	if body, _ := io.ReadAll(response.Body); !strings.Contains(string(body), "SharedAccessKey=abc") {
+		t.Fatalf("expected the response body to be retained but got %q", string(body))
+	}
+
+	if len(lines) != 1 {
+		t.Fatalf("expected 1 log line but got %d: %+v", len(lines), lines)
+	}
+	if strings.Contains(lines[0], "P@ssw0rd") || strings.Contains(lines[0], "abc123") || strings.Contains(lines[0], "SharedAccessKey") {
+		t.Fatalf("expected the sensitive values to be redacted but got %q", lines[0])
+	}
+
+	var entry httpLogEntry
+	if err := json.Unmarshal([]byte(strings.TrimPrefix(lines[0], "[DEBUG] ")), &entry); err != nil {
+		t.Fatalf("parsing log line %q: %+v", lines[0], err)
+	}
+	if entry.Method != http.MethodPut || entry.StatusCode != http.StatusOK || entry.RetryCount != 1 {
+		t.Fatalf("expected a PUT returning a 200 after 1 retry but got %+v", entry)
+	}
+	if entry.CorrelationRequestID != "11111111-1111-1111-1111-111111111111" {
+		t.Fatalf("expected the correlation request ID to be logged but got %q", entry.CorrelationRequestID)
+	}
+	if expected := server.URL + "/example?api-version=2023-01-01&sig=REDACTED"; entry.URL != expected {
+		t.Fatalf("expected the URL %q but got %q", expected, entry.URL)
+	}
+}
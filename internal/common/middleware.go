@@ -8,6 +8,7 @@ import (
 	"net/http"
 	"net/http/httputil"
 
+	"github.com/Azure/go-autorest/autorest"
 	"github.com/hashicorp/go-azure-sdk/sdk/client"
 )
 
@@ -57,3 +58,67 @@ func responseLoggerMiddleware(providerName string) client.ResponseMiddleware {
 		return response, nil
 	}
 }
+
+func requestThrottlingMiddleware(throttler *RequestThrottler) client.RequestMiddleware {
+	return func(request *http.Request) (*http.Request, error) {
+		if err := throttler.Wait(request.Context(), request); err != nil {
+			return nil, err
+		}
+		return request, nil
+	}
+}
+
+func responseThrottlingMiddleware(throttler *RequestThrottler) client.ResponseMiddleware {
+	return func(request *http.Request, response *http.Response) (*http.Response, error) {
+		throttler.Observe(request, response)
+		return response, nil
+	}
+}
+
+// withRequestThrottling wraps the Sender used by go-autorest clients, since these don't support middlewares
+func withRequestThrottling(throttler *RequestThrottler, sender autorest.Sender) autorest.Sender {
+	return autorest.SenderFunc(func(request *http.Request) (*http.Response, error) {
+		if err := throttler.Wait(request.Context(), request); err != nil {
+			return nil, err
+		}
+
+		response, err := sender.Do(request)
+		throttler.Observe(request, response)
+		return response, err
+	})
+}
+
+// withHTTPRecorder wraps the Sender used by go-autorest clients, since these don't support middlewares
+func withHTTPRecorder(recorder HTTPRecorder, sender autorest.Sender) autorest.Sender {
+	return autorest.SenderFunc(func(request *http.Request) (*http.Response, error) {
+		request, err := recorder.PrepareRequest(request)
+		if err != nil {
+			return nil, err
+		}
+
+		response, err := sender.Do(request)
+		if err != nil {
+			return response, err
+		}
+
+		return recorder.RecordResponse(request, response)
+	})
+}
+
+// withHTTPLogger wraps the Sender used by go-autorest clients, since these don't support middlewares - as go-autorest
+// retries requests outside of the Sender, each attempt is logged separately
+func withHTTPLogger(logger *HTTPLogger, sender autorest.Sender) autorest.Sender {
+	return autorest.SenderFunc(func(request *http.Request) (*http.Response, error) {
+		start := logger.now()
+		requestBody, err := readBody(&request.Body)
+		if err != nil {
+			return nil, err
+		}
+
+		response, err := sender.Do(request)
+		if _, logErr := logger.log(request, start, requestBody, 0, response, err); err == nil && logErr != nil {
+			return response, logErr
+		}
+		return response, err
+	})
+}
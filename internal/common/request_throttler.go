@@ -0,0 +1,229 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package common
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// headerRateLimitRemainingPrefix is the prefix of the headers Resource Manager uses to return the number of
+	// requests remaining within the current throttling window, for example `x-ms-ratelimit-remaining-subscription-reads`
+	// or `x-ms-ratelimit-remaining-resource` (which contains a list of `{policy};{remaining}` pairs)
+	headerRateLimitRemainingPrefix = "x-ms-ratelimit-remaining-"
+
+	// defaultThrottledRetryAfter is used when Resource Manager returns a 429 without a (valid) `Retry-After` header
+	defaultThrottledRetryAfter = 10 * time.Second
+)
+
+// RequestThrottler limits the rate at which requests are sent to Resource Manager, per Subscription and per
+// Resource Provider, based on the `x-ms-ratelimit-remaining-*` headers returned in each response.
+//
+// Whilst the number of remaining requests is above the threshold, requests are sent as normal - once it drops
+// below the threshold requests are queued and sent at the configured interval until the throttling window has
+// reset. When Resource Manager returns a 429, all requests to that Subscription/Resource Provider are paused
+// until the time specified in the `Retry-After` header.
+type RequestThrottler struct {
+	remainingRequestsThreshold int
+	requestInterval            time.Duration
+
+	lock     sync.Mutex
+	limiters map[string]*requestLimiter
+
+	// now is overridden in tests
+	now func() time.Time
+}
+
+type requestLimiter struct {
+	// remaining is the lowest number of remaining requests from the most recent response, -1 if unknown
+	remaining int
+
+	// pausedUntil is the time until which requests are paused following a 429
+	pausedUntil time.Time
+
+	// nextRequest is the earliest time at which the next queued request can be sent
+	nextRequest time.Time
+}
+
+// NewRequestThrottler returns a RequestThrottler which starts queueing requests once the number of remaining
+// requests drops below remainingRequestsThreshold, sending these every requestInterval
+func NewRequestThrottler(remainingRequestsThreshold int, requestInterval time.Duration) *RequestThrottler {
+	return &RequestThrottler{
+		remainingRequestsThreshold: remainingRequestsThreshold,
+		requestInterval:            requestInterval,
+		limiters:                   make(map[string]*requestLimiter),
+		now:                        time.Now,
+	}
+}
+
+// Wait blocks until the request can be sent, or the Context is cancelled
+func (t *RequestThrottler) Wait(ctx context.Context, request *http.Request) error {
+	key := throttlingKeyForRequest(request)
+	if key == "" {
+		return nil
+	}
+
+	delay := t.reserve(key)
+	if delay <= 0 {
+		return nil
+	}
+
+	log.Printf("[DEBUG] Throttling: delaying request %s %s by %s", request.Method, request.URL.Path, delay)
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return fmt.Errorf("waiting to send throttled request %s %s: %+v", request.Method, request.URL.Path, ctx.Err())
+	case <-timer.C:
+		return nil
+	}
+}
+
+// Observe records the rate limit information returned by Resource Manager in the response
+func (t *RequestThrottler) Observe(request *http.Request, response *http.Response) {
+	key := throttlingKeyForRequest(request)
+	if key == "" || response == nil {
+		return
+	}
+
+	remaining, hasRemaining := remainingRequestsFromHeaders(response.Header)
+
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	limiter := t.limiter(key)
+	if hasRemaining {
+		limiter.remaining = remaining
+	}
+
+	if response.StatusCode == http.StatusTooManyRequests {
+		retryAfter := retryAfterFromHeaders(response.Header, t.now())
+		pausedUntil := t.now().Add(retryAfter)
+		if pausedUntil.After(limiter.pausedUntil) {
+			log.Printf("[DEBUG] Throttling: pausing requests for %q for %s", key, retryAfter)
+			limiter.pausedUntil = pausedUntil
+		}
+	}
+}
+
+// reserve returns how long the caller needs to wait before sending a request for the key
+func (t *RequestThrottler) reserve(key string) time.Duration {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	now := t.now()
+	limiter := t.limiter(key)
+
+	sendAt := now
+	if limiter.pausedUntil.After(sendAt) {
+		sendAt = limiter.pausedUntil
+	}
+
+	if limiter.remaining >= 0 && limiter.remaining < t.remainingRequestsThreshold {
+		if limiter.nextRequest.After(sendAt) {
+			sendAt = limiter.nextRequest
+		}
+		limiter.nextRequest = sendAt.Add(t.requestInterval)
+	}
+
+	return sendAt.Sub(now)
+}
+
+// limiter returns the requestLimiter for the key, the lock must be held by the caller
+func (t *RequestThrottler) limiter(key string) *requestLimiter {
+	limiter, ok := t.limiters[key]
+	if !ok {
+		limiter = &requestLimiter{
+			remaining: -1,
+		}
+		t.limiters[key] = limiter
+	}
+
+	return limiter
+}
+
+// throttlingKeyForRequest returns the Subscription and Resource Provider the request is for, in the format
+// `{subscriptionId}/{resourceProvider}` - or an empty string when the request isn't scoped to a Subscription
+func throttlingKeyForRequest(request *http.Request) string {
+	if request == nil || request.URL == nil {
+		return ""
+	}
+
+	subscriptionId := ""
+	resourceProvider := "microsoft.resources"
+	segments := strings.Split(strings.Trim(strings.ToLower(request.URL.Path), "/"), "/")
+	for i := 0; i < len(segments)-1; i++ {
+		switch segments[i] {
+		case "subscriptions":
+			if subscriptionId == "" {
+				subscriptionId = segments[i+1]
+			}
+		case "providers":
+			// nested resources can span multiple providers, the last one is the one handling the request
+			resourceProvider = segments[i+1]
+		}
+	}
+
+	if subscriptionId == "" {
+		return ""
+	}
+
+	return fmt.Sprintf("%s/%s", subscriptionId, resourceProvider)
+}
+
+// remainingRequestsFromHeaders returns the lowest number of remaining requests from the `x-ms-ratelimit-remaining-*` headers
+func remainingRequestsFromHeaders(headers http.Header) (int, bool) {
+	remaining := -1
+	for name, values := range headers {
+		if !strings.HasPrefix(strings.ToLower(name), headerRateLimitRemainingPrefix) {
+			continue
+		}
+
+		for _, value := range values {
+			for _, item := range strings.Split(value, ",") {
+				// `x-ms-ratelimit-remaining-resource` is in the format `Microsoft.Compute/HighCostGet3Min;107`
+				if i := strings.LastIndex(item, ";"); i >= 0 {
+					item = item[i+1:]
+				}
+
+				v, err := strconv.Atoi(strings.TrimSpace(item))
+				if err != nil {
+					continue
+				}
+
+				if remaining == -1 || v < remaining {
+					remaining = v
+				}
+			}
+		}
+	}
+
+	return remaining, remaining >= 0
+}
+
+// retryAfterFromHeaders parses the `Retry-After` header, which can be either a number of seconds or an HTTP Date
+func retryAfterFromHeaders(headers http.Header, now time.Time) time.Duration {
+	value := strings.TrimSpace(headers.Get("Retry-After"))
+	if value == "" {
+		return defaultThrottledRetryAfter
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second
+	}
+
+	if date, err := http.ParseTime(value); err == nil && date.After(now) {
+		return date.Sub(now)
+	}
+
+	return defaultThrottledRetryAfter
+}
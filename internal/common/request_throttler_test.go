@@ -0,0 +1,223 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package common
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestThrottlingKeyForRequest(t *testing.T) {
+	testData := []struct {
+		URL      string
+		Expected string
+	}{
+		{
+			URL:      "https://management.azure.com/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example/providers/Microsoft.Network/dnsZones/example.com/A/www?api-version=2018-05-01",
+			Expected: "00000000-0000-0000-0000-000000000000/microsoft.network",
+		},
+		{
+			URL:      "https://management.azure.com/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example/providers/Microsoft.Storage/storageAccounts/example/providers/Microsoft.Authorization/roleAssignments/11111111-1111-1111-1111-111111111111",
+			Expected: "00000000-0000-0000-0000-000000000000/microsoft.authorization",
+		},
+		{
+			URL:      "https://management.azure.com/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example",
+			Expected: "00000000-0000-0000-0000-000000000000/microsoft.resources",
+		},
+		{
+			URL:      "https://management.azure.com/providers/Microsoft.Management/managementGroups/example",
+			Expected: "",
+		},
+		{
+			URL:      "https://example.vault.azure.net/secrets/example",
+			Expected: "",
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.URL)
+		request := httptest.NewRequest(http.MethodGet, v.URL, nil)
+		if actual := throttlingKeyForRequest(request); actual != v.Expected {
+			t.Fatalf("expected %q but got %q", v.Expected, actual)
+		}
+	}
+}
+
+func TestRemainingRequestsFromHeaders(t *testing.T) {
+	testData := []struct {
+		Headers  map[string]string
+		Expected int
+		Found    bool
+	}{
+		{
+			Headers: map[string]string{},
+			Found:   false,
+		},
+		{
+			Headers: map[string]string{
+				"X-Ms-Ratelimit-Remaining-Subscription-Reads": "11999",
+			},
+			Expected: 11999,
+			Found:    true,
+		},
+		{
+			Headers: map[string]string{
+				"X-Ms-Ratelimit-Remaining-Subscription-Writes": "1199",
+				"X-Ms-Ratelimit-Remaining-Resource":            "Microsoft.Compute/HighCostGet3Min;107,Microsoft.Compute/HighCostGet30Min;297",
+			},
+			Expected: 107,
+			Found:    true,
+		},
+		{
+			Headers: map[string]string{
+				"X-Ms-Ratelimit-Remaining-Subscription-Reads": "not-a-number",
+			},
+			Found: false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %+v", v.Headers)
+		headers := http.Header{}
+		for k, value := range v.Headers {
+			headers.Set(k, value)
+		}
+
+		actual, found := remainingRequestsFromHeaders(headers)
+		if found != v.Found {
+			t.Fatalf("expected found to be %t but got %t", v.Found, found)
+		}
+		if found && actual != v.Expected {
+			t.Fatalf("expected %d but got %d", v.Expected, actual)
+		}
+	}
+}
+
+func TestRetryAfterFromHeaders(t *testing.T) {
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	testData := []struct {
+		Value    string
+		Expected time.Duration
+	}{
+		{
+			Value:    "",
+			Expected: defaultThrottledRetryAfter,
+		},
+		{
+			Value:    "17",
+			Expected: 17 * time.Second,
+		},
+		{
+			Value:    now.Add(time.Minute).Format(http.TimeFormat),
+			Expected: time.Minute,
+		},
+		{
+			Value:    "soon",
+			Expected: defaultThrottledRetryAfter,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Value)
+		headers := http.Header{}
+		if v.Value != "" {
+			headers.Set("Retry-After", v.Value)
+		}
+
+		if actual := retryAfterFromHeaders(headers, now); actual != v.Expected {
+			t.Fatalf("expected %s but got %s", v.Expected, actual)
+		}
+	}
+}
+
+func TestRequestThrottlerQueuesRequestsBelowThreshold(t *testing.T) {
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	throttler := NewRequestThrottler(100, 500*time.Millisecond)
+	throttler.now = func() time.Time {
+		return now
+	}
+
+	key := "00000000-0000-0000-0000-000000000000/microsoft.network"
+	request := httptest.NewRequest(http.MethodGet, "https://management.azure.com/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example/providers/Microsoft.Network/dnsZones/example.com", nil)
+
+	// until a response has been observed, requests aren't delayed
+	if delay := throttler.reserve(key); delay != 0 {
+		t.Fatalf("expected no delay before a response has been observed but got %s", delay)
+	}
+
+	// whilst the number of remaining requests is above the threshold, requests aren't delayed
+	throttler.Observe(request, &http.Response{
+		StatusCode: http.StatusOK,
+		Header: http.Header{
+			"X-Ms-Ratelimit-Remaining-Subscription-Reads": []string{"500"},
+		},
+	})
+	if delay := throttler.reserve(key); delay != 0 {
+		t.Fatalf("expected no delay above the threshold but got %s", delay)
+	}
+
+	// below the threshold requests are queued at the request interval
+	throttler.Observe(request, &http.Response{
+		StatusCode: http.StatusOK,
+		Header: http.Header{
+			"X-Ms-Ratelimit-Remaining-Subscription-Reads": []string{"50"},
+		},
+	})
+	for i, expected := range []time.Duration{0, 500 * time.Millisecond, time.Second} {
+		if delay := throttler.reserve(key); delay != expected {
+			t.Fatalf("expected request %d to be delayed by %s but got %s", i, expected, delay)
+		}
+	}
+
+	// other Resource Providers are unaffected
+	if delay := throttler.reserve("00000000-0000-0000-0000-000000000000/microsoft.compute"); delay != 0 {
+		t.Fatalf("expected no delay for a different Resource Provider but got %s", delay)
+	}
+}
+
+func TestRequestThrottlerPausesAfterTooManyRequests(t *testing.T) {
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	throttler := NewRequestThrottler(100, 500*time.Millisecond)
+	throttler.now = func() time.Time {
+		return now
+	}
+
+	key := "00000000-0000-0000-0000-000000000000/microsoft.authorization"
+	request := httptest.NewRequest(http.MethodPut, "https://management.azure.com/subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.Authorization/roleAssignments/11111111-1111-1111-1111-111111111111", nil)
+	throttler.Observe(request, &http.Response{
+		StatusCode: http.StatusTooManyRequests,
+		Header: http.Header{
+			"Retry-After": []string{"30"},
+		},
+	})
+
+	if delay := throttler.reserve(key); delay != 30*time.Second {
+		t.Fatalf("expected the request to be paused for 30s but got %s", delay)
+	}
+
+	now = now.Add(31 * time.Second)
+	if delay := throttler.reserve(key); delay != 0 {
+		t.Fatalf("expected no delay once the pause has elapsed but got %s", delay)
+	}
+}
+
+func TestRequestThrottlerWaitIsCancelledWithContext(t *testing.T) {
+	throttler := NewRequestThrottler(100, 500*time.Millisecond)
+	request := httptest.NewRequest(http.MethodGet, "https://management.azure.com/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example", nil)
+	throttler.Observe(request, &http.Response{
+		StatusCode: http.StatusTooManyRequests,
+		Header: http.Header{
+			"Retry-After": []string{"3600"},
+		},
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err := throttler.Wait(ctx, request); err == nil {
+		t.Fatalf("expected an error when the Context is cancelled but didn't get one")
+	}
+}
@@ -0,0 +1,146 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package diskcache
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+)
+
+// Cache is an opt-in cache stored on disk, used to persist the results of expensive List operations (for example
+// the Resource Providers or Key Vaults within a Subscription) between Terraform runs.
+//
+// Items are stored in a file per Tenant, Subscription and name and expire after the configured Time To Live, at
+// which point they're retrieved from the API again. A nil Cache is valid and never contains any items.
+type Cache struct {
+	directory  string
+	tenantId   string
+	timeToLive time.Duration
+}
+
+type cacheItem struct {
+	ExpiresOn time.Time       `json:"expiresOn"`
+	Value     json.RawMessage `json:"value"`
+}
+
+// New returns a Cache which stores items for the specified Tenant within the directory, expiring them after timeToLive
+func New(directory string, tenantId string, timeToLive time.Duration) *Cache {
+	return &Cache{
+		directory:  directory,
+		tenantId:   strings.ToLower(tenantId),
+		timeToLive: timeToLive,
+	}
+}
+
+// DefaultDirectory returns the directory used to store the Cache when one isn't specified
+func DefaultDirectory() (string, error) {
+	directory, err := os.UserCacheDir()
+	if err != nil {
+		return "", fmt.Errorf("determining the user cache directory: %+v", err)
+	}
+
+	return filepath.Join(directory, "terraform-provider-azurerm"), nil
+}
+
+// Get populates value with the cached item for the Subscription, returning false when the item isn't cached or has expired
+func (c *Cache) Get(subscriptionId commonids.SubscriptionId, name string, value interface{}) bool {
+	if c == nil {
+		return false
+	}
+
+	path := c.pathForItem(subscriptionId, name)
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			log.Printf("[DEBUG] Unable to read the cached %q from %q: %+v", name, path, err)
+		}
+		return false
+	}
+
+	var item cacheItem
+	if err := json.Unmarshal(contents, &item); err != nil {
+		log.Printf("[DEBUG] Unable to parse the cached %q from %q: %+v", name, path, err)
+		return false
+	}
+	if time.Now().After(item.ExpiresOn) {
+		log.Printf("[DEBUG] The cached %q for %s expired at %s", name, subscriptionId, item.ExpiresOn.Format(time.RFC3339))
+		return false
+	}
+	if err := json.Unmarshal(item.Value, value); err != nil {
+		log.Printf("[DEBUG] Unable to parse the cached %q from %q: %+v", name, path, err)
+		return false
+	}
+
+	log.Printf("[DEBUG] Using the cached %q for %s", name, subscriptionId)
+	return true
+}
+
+// Set caches the value for the Subscription, replacing any existing item
+func (c *Cache) Set(subscriptionId commonids.SubscriptionId, name string, value interface{}) error {
+	if c == nil {
+		return nil
+	}
+
+	raw, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Errorf("marshaling %q: %+v", name, err)
+	}
+	contents, err := json.Marshal(cacheItem{
+		ExpiresOn: time.Now().Add(c.timeToLive),
+		Value:     raw,
+	})
+	if err != nil {
+		return fmt.Errorf("marshaling %q: %+v", name, err)
+	}
+
+	path := c.pathForItem(subscriptionId, name)
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return fmt.Errorf("creating the directory for %q: %+v", path, err)
+	}
+
+	// write to a temporary file and then rename it, so that concurrent Terraform runs never read a partial file
+	file, err := os.CreateTemp(filepath.Dir(path), fmt.Sprintf("%s-*.tmp", name))
+	if err != nil {
+		return fmt.Errorf("creating a temporary file for %q: %+v", path, err)
+	}
+	defer os.Remove(file.Name())
+
+	if _, err := file.Write(contents); err != nil {
+		file.Close()
+		return fmt.Errorf("writing %q: %+v", file.Name(), err)
+	}
+	if err := file.Close(); err != nil {
+		return fmt.Errorf("closing %q: %+v", file.Name(), err)
+	}
+	if err := os.Rename(file.Name(), path); err != nil {
+		return fmt.Errorf("renaming %q to %q: %+v", file.Name(), path, err)
+	}
+
+	return nil
+}
+
+// Delete removes the cached item for the Subscription, if it exists
+func (c *Cache) Delete(subscriptionId commonids.SubscriptionId, name string) error {
+	if c == nil {
+		return nil
+	}
+
+	path := c.pathForItem(subscriptionId, name)
+	if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("removing %q: %+v", path, err)
+	}
+
+	return nil
+}
+
+func (c *Cache) pathForItem(subscriptionId commonids.SubscriptionId, name string) string {
+	return filepath.Join(c.directory, c.tenantId, strings.ToLower(subscriptionId.SubscriptionId), fmt.Sprintf("%s.json", name))
+}
@@ -0,0 +1,78 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package diskcache
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+)
+
+func TestCache(t *testing.T) {
+	directory := t.TempDir()
+	subscriptionId := commonids.NewSubscriptionID("11111111-1111-1111-1111-111111111111")
+	otherSubscriptionId := commonids.NewSubscriptionID("22222222-2222-2222-2222-222222222222")
+
+	cache := New(directory, "00000000-0000-0000-0000-000000000000", time.Hour)
+	expected := []string{"Microsoft.Compute", "Microsoft.Network"}
+	if err := cache.Set(subscriptionId, "resourceProviders", expected); err != nil {
+		t.Fatalf("caching item: %+v", err)
+	}
+
+	var actual []string
+	if !cache.Get(subscriptionId, "resourceProviders", &actual) {
+		t.Fatalf("expected the item to be cached but it wasn't")
+	}
+	if !reflect.DeepEqual(expected, actual) {
+		t.Fatalf("expected %+v but got %+v", expected, actual)
+	}
+
+	if cache.Get(otherSubscriptionId, "resourceProviders", &actual) {
+		t.Fatalf("expected the item not to be cached for a different Subscription")
+	}
+	if New(directory, "33333333-3333-3333-3333-333333333333", time.Hour).Get(subscriptionId, "resourceProviders", &actual) {
+		t.Fatalf("expected the item not to be cached for a different Tenant")
+	}
+	if cache.Get(subscriptionId, "keyVaults", &actual) {
+		t.Fatalf("expected an item which hasn't been cached not to be returned")
+	}
+
+	if err := cache.Delete(subscriptionId, "resourceProviders"); err != nil {
+		t.Fatalf("deleting item: %+v", err)
+	}
+	if cache.Get(subscriptionId, "resourceProviders", &actual) {
+		t.Fatalf("expected a deleted item not to be returned")
+	}
+	if err := cache.Delete(subscriptionId, "resourceProviders"); err != nil {
+		t.Fatalf("expected no error deleting an item which isn't cached but got: %+v", err)
+	}
+}
+
+func TestCacheExpiry(t *testing.T) {
+	subscriptionId := commonids.NewSubscriptionID("11111111-1111-1111-1111-111111111111")
+	cache := New(t.TempDir(), "00000000-0000-0000-0000-000000000000", -time.Minute)
+	if err := cache.Set(subscriptionId, "resourceProviders", []string{"Microsoft.Compute"}); err != nil {
+		t.Fatalf("caching item: %+v", err)
+	}
+
+	var actual []string
+	if cache.Get(subscriptionId, "resourceProviders", &actual) {
+		t.Fatalf("expected an expired item not to be returned")
+	}
+}
+
+func TestCacheNil(t *testing.T) {
+	var cache *Cache
+	subscriptionId := commonids.NewSubscriptionID("11111111-1111-1111-1111-111111111111")
+	if err := cache.Set(subscriptionId, "resourceProviders", []string{"Microsoft.Compute"}); err != nil {
+		t.Fatalf("expected no error caching an item in a nil Cache but got: %+v", err)
+	}
+
+	var actual []string
+	if cache.Get(subscriptionId, "resourceProviders", &actual) {
+		t.Fatalf("expected a nil Cache never to contain any items")
+	}
+}
@@ -0,0 +1,73 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+)
+
+func expandDefaultTimeouts(input []interface{}) ([]timeouts.DefaultTimeouts, error) {
+	output := make([]timeouts.DefaultTimeouts, 0)
+
+	for _, item := range input {
+		// an empty block doesn't override anything
+		if item == nil {
+			continue
+		}
+
+		raw := item.(map[string]interface{})
+		defaultTimeouts := timeouts.DefaultTimeouts{}
+
+		for _, v := range raw["resource_types"].([]interface{}) {
+			if resourceType, ok := v.(string); ok && resourceType != "" {
+				defaultTimeouts.ResourceTypes = append(defaultTimeouts.ResourceTypes, resourceType)
+			}
+		}
+
+		for key, target := range map[string]**time.Duration{
+			"create": &defaultTimeouts.Create,
+			"read":   &defaultTimeouts.Read,
+			"update": &defaultTimeouts.Update,
+			"delete": &defaultTimeouts.Delete,
+		} {
+			v, ok := raw[key].(string)
+			if !ok || v == "" {
+				continue
+			}
+
+			duration, err := time.ParseDuration(v)
+			if err != nil {
+				return nil, fmt.Errorf("parsing the `%s` timeout %q within `default_timeouts`: %+v", key, v, err)
+			}
+			*target = &duration
+		}
+
+		output = append(output, defaultTimeouts)
+	}
+
+	return output, nil
+}
+
+func ValidateDefaultTimeout(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	duration, err := time.ParseDuration(v)
+	if err != nil {
+		errors = append(errors, fmt.Errorf("expected %q to be a duration such as `30m` or `3h`, got %q: %+v", k, v, err))
+		return
+	}
+
+	if duration <= 0 {
+		errors = append(errors, fmt.Errorf("expected %q to be a duration greater than zero, got %q", k, v))
+	}
+
+	return
+}
@@ -0,0 +1,42 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/diskcache"
+)
+
+func expandDiskCache(input []interface{}) (*clients.DiskCache, error) {
+	if len(input) == 0 {
+		return nil, nil
+	}
+
+	// an empty block enables the disk cache using the default values
+	output := clients.DiskCache{
+		TimeToLive: clients.DefaultDiskCacheTimeToLiveInMinutes * time.Minute,
+	}
+
+	if input[0] != nil {
+		raw := input[0].(map[string]interface{})
+		if v, ok := raw["directory"].(string); ok && v != "" {
+			output.Directory = v
+		}
+		if v, ok := raw["time_to_live_in_minutes"].(int); ok && v > 0 {
+			output.TimeToLive = time.Duration(v) * time.Minute
+		}
+	}
+
+	if output.Directory == "" {
+		directory, err := diskcache.DefaultDirectory()
+		if err != nil {
+			return nil, err
+		}
+		output.Directory = directory
+	}
+
+	return &output, nil
+}
@@ -5,6 +5,7 @@ package framework
 
 import (
 	"context"
+	"fmt"
 	"os"
 	"time"
 
@@ -12,10 +13,13 @@ import (
 	"github.com/hashicorp/go-azure-sdk/sdk/auth"
 	"github.com/hashicorp/go-azure-sdk/sdk/environments"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/diskcache"
 	providerfeatures "github.com/hashicorp/terraform-provider-azurerm/internal/features"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/provider"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/resourceproviders"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 )
 
 type ProviderConfig struct {
@@ -126,6 +130,133 @@ func (p *ProviderConfig) Load(ctx context.Context, data *ProviderModel, tfVersio
 	p.clientBuilder.DisableTerraformPartnerID = getEnvBoolOrDefault(data.DisableTerraformPartnerId, "ARM_DISABLE_TERRAFORM_PARTNER_ID", false)
 	p.clientBuilder.StorageUseAzureAD = getEnvBoolOrDefault(data.StorageUseAzureAD, "ARM_STORAGE_USE_AZUREAD", false)
 
+	if !data.RequestThrottling.IsNull() && !data.RequestThrottling.IsUnknown() {
+		var requestThrottlingList []RequestThrottling
+		diags.Append(data.RequestThrottling.ElementsAs(ctx, &requestThrottlingList, true)...)
+		if diags.HasError() {
+			return
+		}
+
+		if len(requestThrottlingList) > 0 {
+			requestThrottling := clients.RequestThrottling{
+				RemainingRequestsThreshold: clients.DefaultRequestThrottlingRemainingRequestsThreshold,
+				RequestInterval:            clients.DefaultRequestThrottlingRequestIntervalInMilliseconds * time.Millisecond,
+			}
+			if v := requestThrottlingList[0].RemainingRequestsThreshold; !v.IsNull() && !v.IsUnknown() {
+				requestThrottling.RemainingRequestsThreshold = int(v.ValueInt64())
+			}
+			if v := requestThrottlingList[0].RequestIntervalInMilliseconds; !v.IsNull() && !v.IsUnknown() {
+				requestThrottling.RequestInterval = time.Duration(v.ValueInt64()) * time.Millisecond
+			}
+			p.clientBuilder.RequestThrottling = &requestThrottling
+		}
+	}
+
+	if !data.DiskCache.IsNull() && !data.DiskCache.IsUnknown() {
+		var diskCacheList []DiskCache
+		diags.Append(data.DiskCache.ElementsAs(ctx, &diskCacheList, true)...)
+		if diags.HasError() {
+			return
+		}
+
+		if len(diskCacheList) > 0 {
+			diskCache := clients.DiskCache{
+				Directory:  diskCacheList[0].Directory.ValueString(),
+				TimeToLive: clients.DefaultDiskCacheTimeToLiveInMinutes * time.Minute,
+			}
+			if diskCache.Directory == "" {
+				directory, err := diskcache.DefaultDirectory()
+				if err != nil {
+					diags.AddError("configuring `disk_cache`", err.Error())
+					return
+				}
+				diskCache.Directory = directory
+			}
+			if v := diskCacheList[0].TimeToLiveInMinutes; !v.IsNull() && !v.IsUnknown() {
+				diskCache.TimeToLive = time.Duration(v.ValueInt64()) * time.Minute
+			}
+			p.clientBuilder.DiskCache = &diskCache
+		}
+	}
+
+	if !data.StructuredLogging.IsNull() && !data.StructuredLogging.IsUnknown() {
+		var structuredLoggingList []StructuredLogging
+		diags.Append(data.StructuredLogging.ElementsAs(ctx, &structuredLoggingList, true)...)
+		if diags.HasError() {
+			return
+		}
+
+		if len(structuredLoggingList) > 0 {
+			structuredLogging := clients.StructuredLogging{
+				RedactedFields:  make([]string, 0),
+				SensitiveFields: provider.SensitiveAttributeNames(provider.AzureProvider()),
+			}
+			if v := structuredLoggingList[0].RedactedFields; !v.IsNull() && !v.IsUnknown() {
+				diags.Append(v.ElementsAs(ctx, &structuredLogging.RedactedFields, true)...)
+				if diags.HasError() {
+					return
+				}
+			}
+			p.clientBuilder.StructuredLogging = &structuredLogging
+		}
+	}
+
+	if !data.Tracing.IsNull() && !data.Tracing.IsUnknown() {
+		var tracingList []Tracing
+		diags.Append(data.Tracing.ElementsAs(ctx, &tracingList, true)...)
+		if diags.HasError() {
+			return
+		}
+
+		if len(tracingList) > 0 {
+			p.clientBuilder.Tracing = &clients.Tracing{
+				Endpoint: tracingList[0].Endpoint.ValueString(),
+			}
+		}
+	}
+
+	if !data.DefaultTimeouts.IsNull() && !data.DefaultTimeouts.IsUnknown() {
+		var defaultTimeoutsList []DefaultTimeouts
+		diags.Append(data.DefaultTimeouts.ElementsAs(ctx, &defaultTimeoutsList, true)...)
+		if diags.HasError() {
+			return
+		}
+
+		for _, v := range defaultTimeoutsList {
+			defaultTimeouts := timeouts.DefaultTimeouts{}
+
+			if !v.ResourceTypes.IsNull() && !v.ResourceTypes.IsUnknown() {
+				diags.Append(v.ResourceTypes.ElementsAs(ctx, &defaultTimeouts.ResourceTypes, true)...)
+				if diags.HasError() {
+					return
+				}
+			}
+
+			for key, item := range map[string]struct {
+				value  types.String
+				target **time.Duration
+			}{
+				"create": {value: v.Create, target: &defaultTimeouts.Create},
+				"read":   {value: v.Read, target: &defaultTimeouts.Read},
+				"update": {value: v.Update, target: &defaultTimeouts.Update},
+				"delete": {value: v.Delete, target: &defaultTimeouts.Delete},
+			} {
+				if item.value.IsNull() || item.value.IsUnknown() || item.value.ValueString() == "" {
+					continue
+				}
+
+				duration, err := time.ParseDuration(item.value.ValueString())
+				if err != nil {
+					diags.AddError("parsing `default_timeouts`", fmt.Sprintf("parsing the `%s` timeout %q: %+v", key, item.value.ValueString(), err))
+					return
+				}
+				*item.target = &duration
+			}
+
+			p.clientBuilder.DefaultTimeouts = append(p.clientBuilder.DefaultTimeouts, defaultTimeouts)
+		}
+	}
+
 	f := providerfeatures.UserFeatures{}
 
 	// features is required, but we'll play safe here
@@ -521,7 +652,7 @@ func (p *ProviderConfig) Load(ctx context.Context, data *ProviderModel, tfVersio
 	ctx2, cancel := context.WithTimeout(ctx, 30*time.Minute)
 	defer cancel()
 
-	if err = resourceproviders.EnsureRegistered(ctx2, client.Resource.ResourceProvidersClient, subId, requiredResourceProviders); err != nil {
+	if err = resourceproviders.EnsureRegistered(ctx2, client.Resource.ResourceProvidersClient, subId, requiredResourceProviders, client.DiskCache); err != nil {
 		diags.AddError("registering resource providers", err.Error())
 		return
 	}
@@ -0,0 +1,31 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+)
+
+func TestProtoV5ProviderServerFactory_providerSchemasMatch(t *testing.T) {
+	ctx := context.Background()
+	serverFactory, _, err := ProtoV5ProviderServerFactory(ctx)
+	if err != nil {
+		t.Fatalf("building the Provider Server: %+v", err)
+	}
+
+	// the Plugin SDKv2 and Plugin Framework providers are muxed, which requires the provider block to be identical
+	resp, err := serverFactory().GetProviderSchema(ctx, &tfprotov5.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatalf("retrieving the Provider Schema: %+v", err)
+	}
+
+	for _, d := range resp.Diagnostics {
+		if d.Severity == tfprotov5.DiagnosticSeverityError {
+			t.Fatalf("retrieving the Provider Schema: %s: %s", d.Summary, d.Detail)
+		}
+	}
+}
@@ -35,11 +35,42 @@ type ProviderModel struct {
 	DisableTerraformPartnerId     types.Bool   `tfsdk:"disable_terraform_partner_id"`
 	StorageUseAzureAD             types.Bool   `tfsdk:"storage_use_azuread"`
 	Features                      types.List   `tfsdk:"features"`
+	RequestThrottling             types.List   `tfsdk:"request_throttling"`
+	DefaultTimeouts               types.List   `tfsdk:"default_timeouts"`
+	DiskCache                     types.List   `tfsdk:"disk_cache"`
+	StructuredLogging             types.List   `tfsdk:"structured_logging"`
+	Tracing                       types.List   `tfsdk:"tracing"`
 	SkipProviderRegistration      types.Bool   `tfsdk:"skip_provider_registration"` // TODO - Remove in 5.0
 	ResourceProviderRegistrations types.String `tfsdk:"resource_provider_registrations"`
 	ResourceProvidersToRegister   types.List   `tfsdk:"resource_providers_to_register"`
 }
 
+type RequestThrottling struct {
+	RemainingRequestsThreshold    types.Int64 `tfsdk:"remaining_requests_threshold"`
+	RequestIntervalInMilliseconds types.Int64 `tfsdk:"request_interval_in_milliseconds"`
+}
+
+type DiskCache struct {
+	Directory           types.String `tfsdk:"directory"`
+	TimeToLiveInMinutes types.Int64  `tfsdk:"time_to_live_in_minutes"`
+}
+
+type StructuredLogging struct {
+	RedactedFields types.List `tfsdk:"redacted_fields"`
+}
+
+type Tracing struct {
+	Endpoint types.String `tfsdk:"endpoint"`
+}
+
+type DefaultTimeouts struct {
+	ResourceTypes types.List   `tfsdk:"resource_types"`
+	Create        types.String `tfsdk:"create"`
+	Read          types.String `tfsdk:"read"`
+	Update        types.String `tfsdk:"update"`
+	Delete        types.String `tfsdk:"delete"`
+}
+
 type Features struct {
 	APIManagement            types.List `tfsdk:"api_management"`
 	AppConfiguration         types.List `tfsdk:"app_configuration"`
@@ -6,17 +6,21 @@ package framework
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	azurermprovider "github.com/hashicorp/terraform-provider-azurerm/internal/provider"
 	providerfunction "github.com/hashicorp/terraform-provider-azurerm/internal/provider/function"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/resourceproviders"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk/frameworkhelpers"
 )
 
@@ -29,10 +33,16 @@ var _ provider.Provider = &azureRmFrameworkProvider{}
 
 var _ provider.ProviderWithFunctions = &azureRmFrameworkProvider{}
 
+var _ provider.ProviderWithEphemeralResources = &azureRmFrameworkProvider{}
+
 func (p *azureRmFrameworkProvider) Functions(_ context.Context) []func() function.Function {
 	return []func() function.Function{
+		providerfunction.NewBuildResourceIDFunction,
+		providerfunction.NewCIDROverlapsFunction,
+		providerfunction.NewNextFreeSubnetFunction,
 		providerfunction.NewNormaliseResourceIDFunction,
 		providerfunction.NewParseResourceIDFunction,
+		providerfunction.NewSubnetUsableHostsFunction,
 	}
 }
 
@@ -224,6 +234,151 @@ func (p *azureRmFrameworkProvider) Schema(_ context.Context, _ provider.SchemaRe
 		},
 
 		Blocks: map[string]schema.Block{
+			"request_throttling": schema.ListNestedBlock{
+				Description: "Throttles the requests sent to Azure Resource Manager for each Subscription and Resource Provider, based on the number of requests remaining before Azure Resource Manager starts to throttle requests.",
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"remaining_requests_threshold": schema.Int64Attribute{
+							Optional:    true,
+							Description: "The number of remaining requests below which requests are queued. Defaults to `100`.",
+							Validators: []validator.Int64{
+								int64validator.AtLeast(1),
+							},
+						},
+
+						"request_interval_in_milliseconds": schema.Int64Attribute{
+							Optional:    true,
+							Description: "The interval in milliseconds at which queued requests are sent. Defaults to `500`.",
+							Validators: []validator.Int64{
+								int64validator.AtLeast(1),
+							},
+						},
+					},
+				},
+			},
+
+			"disk_cache": schema.ListNestedBlock{
+				Description: "Caches the Resource Providers and Key Vaults within the Subscription on disk, to avoid listing these on every Terraform run.",
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"directory": schema.StringAttribute{
+							Optional:    true,
+							Description: "The directory in which the cache is stored. Defaults to a `terraform-provider-azurerm` directory within the user's cache directory.",
+							Validators: []validator.String{
+								stringvalidator.LengthAtLeast(1),
+							},
+						},
+
+						"time_to_live_in_minutes": schema.Int64Attribute{
+							Optional:    true,
+							Description: "The number of minutes after which cached items expire. Defaults to `60`.",
+							Validators: []validator.Int64{
+								int64validator.AtLeast(1),
+							},
+						},
+					},
+				},
+			},
+
+			"structured_logging": schema.ListNestedBlock{
+				Description: "Logs a single line of JSON for each request sent to Azure in place of the full request and response, redacting the values of sensitive fields.",
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"redacted_fields": schema.ListAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "A list of additional field names whose values are redacted, in addition to Sensitive attributes and fields ending with `password`, `secret`, `key`, `token`, `connection_string`, `credential` or `sig`.",
+							Validators: []validator.List{
+								listvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+							},
+						},
+					},
+				},
+			},
+
+			"tracing": schema.ListNestedBlock{
+				Description: "Exports OpenTelemetry spans for each operation performed on a Resource and each request sent to Azure, using OTLP over HTTP.",
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"endpoint": schema.StringAttribute{
+							Optional:    true,
+							Description: "The URL of the OTLP/HTTP endpoint which spans are exported to, for example `http://localhost:4318`. Defaults to the endpoint specified in the `OTEL_EXPORTER_OTLP_TRACES_ENDPOINT` or `OTEL_EXPORTER_OTLP_ENDPOINT` environment variables.",
+							Validators: []validator.String{
+								stringvalidator.LengthAtLeast(1),
+							},
+						},
+					},
+				},
+			},
+
+			"default_timeouts": schema.ListNestedBlock{
+				Description: "Overrides the default timeouts used by Resources, optionally limited to specific Resource Types. Timeouts specified in a Resource's `timeouts` block take precedence over these.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"resource_types": schema.ListAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "The Resource Types these timeouts apply to, each of which can end with a `*` wildcard (for example `azurerm_kubernetes_*`). When omitted these timeouts apply to all Resources.",
+							Validators: []validator.List{
+								listvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+							},
+						},
+
+						"create": schema.StringAttribute{
+							Optional:    true,
+							Description: "The default timeout used when creating Resources, for example `3h`.",
+							Validators: []validator.String{
+								frameworkhelpers.WrappedStringValidator{
+									Func: azurermprovider.ValidateDefaultTimeout,
+								},
+							},
+						},
+
+						"read": schema.StringAttribute{
+							Optional:    true,
+							Description: "The default timeout used when reading Resources, for example `10m`.",
+							Validators: []validator.String{
+								frameworkhelpers.WrappedStringValidator{
+									Func: azurermprovider.ValidateDefaultTimeout,
+								},
+							},
+						},
+
+						"update": schema.StringAttribute{
+							Optional:    true,
+							Description: "The default timeout used when updating Resources, for example `3h`.",
+							Validators: []validator.String{
+								frameworkhelpers.WrappedStringValidator{
+									Func: azurermprovider.ValidateDefaultTimeout,
+								},
+							},
+						},
+
+						"delete": schema.StringAttribute{
+							Optional:    true,
+							Description: "The default timeout used when deleting Resources, for example `3h`.",
+							Validators: []validator.String{
+								frameworkhelpers.WrappedStringValidator{
+									Func: azurermprovider.ValidateDefaultTimeout,
+								},
+							},
+						},
+					},
+				},
+			},
+
 			"features": schema.ListNestedBlock{
 				Validators: []validator.List{
 					listvalidator.SizeBetween(1, 1),
@@ -474,20 +629,43 @@ func (p *azureRmFrameworkProvider) Configure(ctx context.Context, request provid
 
 		response.ResourceData = v
 		response.DataSourceData = v
+		response.EphemeralResourceData = v
 	} else {
 		p.Load(ctx, &data, request.TerraformVersion, &response.Diagnostics)
 
 		response.DataSourceData = &p.ProviderConfig
 		response.ResourceData = &p.ProviderConfig
+		response.EphemeralResourceData = &p.ProviderConfig
 	}
 }
 
 func (p *azureRmFrameworkProvider) DataSources(_ context.Context) []func() datasource.DataSource {
-	// We do not currently support any Native framework Data Sources
-	return nil
+	dataSources := make([]func() datasource.DataSource, 0)
+	for _, service := range azurermprovider.SupportedFrameworkServices() {
+		for _, ds := range service.FrameworkDataSources() {
+			dataSources = append(dataSources, sdk.NewFrameworkDataSourceWrapper(ds))
+		}
+	}
+
+	return dataSources
 }
 
 func (p *azureRmFrameworkProvider) Resources(_ context.Context) []func() resource.Resource {
-	// We do not currently support any Native framework Resources
-	return nil
+	resources := make([]func() resource.Resource, 0)
+	for _, service := range azurermprovider.SupportedFrameworkServices() {
+		for _, r := range service.FrameworkResources() {
+			resources = append(resources, sdk.NewFrameworkResourceWrapper(r))
+		}
+	}
+
+	return resources
+}
+
+func (p *azureRmFrameworkProvider) EphemeralResources(_ context.Context) []func() ephemeral.EphemeralResource {
+	ephemeralResources := make([]func() ephemeral.EphemeralResource, 0)
+	for _, service := range azurermprovider.SupportedEphemeralServices() {
+		ephemeralResources = append(ephemeralResources, service.EphemeralResources()...)
+	}
+
+	return ephemeralResources
 }
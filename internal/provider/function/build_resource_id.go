@@ -0,0 +1,123 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/recaser"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type BuildResourceIDFunction struct{}
+
+var _ function.Function = BuildResourceIDFunction{}
+
+var idBuildSegmentTypes = map[string]attr.Type{
+	"type": types.StringType,
+	"name": types.StringType,
+}
+
+type buildResourceIDSegment struct {
+	Type string `tfsdk:"type"`
+	Name string `tfsdk:"name"`
+}
+
+func NewBuildResourceIDFunction() function.Function {
+	return &BuildResourceIDFunction{}
+}
+
+func (b BuildResourceIDFunction) Metadata(_ context.Context, _ function.MetadataRequest, response *function.MetadataResponse) {
+	response.Name = "build_resource_id"
+}
+
+func (b BuildResourceIDFunction) Definition(_ context.Context, _ function.DefinitionRequest, response *function.DefinitionResponse) {
+	response.Definition = function.Definition{
+		Summary:             "build_resource_id",
+		Description:         "Builds an Azure Resource Manager ID from the Scope, Resource Provider and an ordered list of Resource Type and Name segments, normalising the casing into the correct casing for Terraform",
+		MarkdownDescription: "Builds an Azure Resource Manager ID from the Scope, Resource Provider and an ordered list of Resource Type and Name segments, normalising the casing into the correct casing for Terraform",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "scope",
+				Description:         "The Scope of the Resource, for example a Subscription or Resource Group ID",
+				MarkdownDescription: "The Scope of the Resource, for example a Subscription or Resource Group ID",
+			},
+			function.StringParameter{
+				Name:                "resource_provider",
+				Description:         "The Resource Provider Namespace, for example `Microsoft.Web`",
+				MarkdownDescription: "The Resource Provider Namespace, for example `Microsoft.Web`",
+			},
+			function.ListParameter{
+				Name:                "segments",
+				Description:         "An ordered list of objects containing the `type` and `name` of each Resource, from the top-level Resource to the nested Resource",
+				MarkdownDescription: "An ordered list of objects containing the `type` and `name` of each Resource, from the top-level Resource to the nested Resource",
+				ElementType: types.ObjectType{
+					AttrTypes: idBuildSegmentTypes,
+				},
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (b BuildResourceIDFunction) Run(ctx context.Context, request function.RunRequest, response *function.RunResponse) {
+	var scope, resourceProvider string
+	var segments []buildResourceIDSegment
+
+	response.Error = function.ConcatFuncErrors(request.Arguments.Get(ctx, &scope, &resourceProvider, &segments))
+
+	if response.Error != nil {
+		return
+	}
+
+	id, err := buildResourceID(scope, resourceProvider, segments)
+	if err != nil {
+		response.Error = function.NewFuncError(err.Error())
+		return
+	}
+
+	result, err := recaser.ReCaseKnownId(id)
+	if err != nil {
+		response.Error = function.NewFuncError(err.Error())
+		return
+	}
+
+	response.Error = function.ConcatFuncErrors(response.Result.Set(ctx, result))
+}
+
+func buildResourceID(scope, resourceProvider string, segments []buildResourceIDSegment) (string, error) {
+	if strings.TrimSpace(resourceProvider) == "" || strings.Contains(resourceProvider, "/") {
+		return "", fmt.Errorf("expected `resource_provider` to be a Resource Provider Namespace (e.g. `Microsoft.Web`) but got %q", resourceProvider)
+	}
+
+	if len(segments) == 0 {
+		return "", fmt.Errorf("at least one segment must be specified")
+	}
+
+	scope = strings.TrimSuffix(scope, "/")
+	if scope != "" && !strings.HasPrefix(scope, "/") {
+		scope = "/" + scope
+	}
+
+	components := []string{
+		scope,
+		"providers",
+		resourceProvider,
+	}
+	for i, segment := range segments {
+		if segment.Type == "" || strings.Contains(segment.Type, "/") {
+			return "", fmt.Errorf("expected `type` for segment %d to be a single Resource Type but got %q", i, segment.Type)
+		}
+		if segment.Name == "" || strings.Contains(segment.Name, "/") {
+			return "", fmt.Errorf("expected `name` for segment %d to be a single Resource Name but got %q", i, segment.Name)
+		}
+		components = append(components, segment.Type, segment.Name)
+	}
+
+	return strings.Join(components, "/"), nil
+}
@@ -0,0 +1,94 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/provider/framework"
+)
+
+func TestProviderFunctionBuildResourceID_basic(t *testing.T) {
+	if !features.FourPointOhBeta() {
+		t.Skipf("skipping test due to missing feature flag")
+	}
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0-beta1"))),
+		},
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		Steps: []resource.TestStep{
+			{
+				Config: testBuildResourceIdOutput("/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/resourcegroups/resGroup1", "microsoft.web", `[{ type = "SITES", name = "site1" }]`),
+				Check: acceptance.ComposeTestCheckFunc(
+					acceptance.TestCheckOutput("id", "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Web/sites/site1"),
+				),
+			},
+		},
+	})
+}
+
+func TestProviderFunctionBuildResourceID_nested(t *testing.T) {
+	if !features.FourPointOhBeta() {
+		t.Skipf("skipping test due to missing feature flag")
+	}
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0-beta1"))),
+		},
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		Steps: []resource.TestStep{
+			{
+				Config: testBuildResourceIdOutput("/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/", "Microsoft.ApiManagement", `[{ type = "service", name = "service1" }, { type = "gateWays", name = "gateway1" }, { type = "hostnameconfigurations", name = "config1" }]`),
+				Check: acceptance.ComposeTestCheckFunc(
+					acceptance.TestCheckOutput("id", "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ApiManagement/service/service1/gateways/gateway1/hostnameConfigurations/config1"),
+				),
+			},
+		},
+	})
+}
+
+func TestProviderFunctionBuildResourceID_unknownType(t *testing.T) {
+	if !features.FourPointOhBeta() {
+		t.Skipf("skipping test due to missing feature flag")
+	}
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0-beta1"))),
+		},
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		Steps: []resource.TestStep{
+			{
+				Config:      testBuildResourceIdOutput("/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1", "Microsoft.Web", `[{ type = "notARealType", name = "example" }]`),
+				ExpectError: regexp.MustCompile("could not determine ID type"),
+			},
+		},
+	})
+}
+
+func testBuildResourceIdOutput(scope, resourceProvider, segments string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+output "id" {
+  value = provider::azurerm::build_resource_id("%s", "%s", %s)
+}
+`, scope, resourceProvider, segments)
+}
@@ -0,0 +1,120 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"encoding/binary"
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
+)
+
+// azureReservedAddressesPerSubnet is the number of addresses Azure reserves within each Subnet, these are the
+// Network Address, the Default Gateway, two addresses used to map the Azure DNS IPs and the Broadcast Address
+// see: https://learn.microsoft.com/azure/virtual-network/virtual-networks-faq#are-there-any-restrictions-on-using-ip-addresses-within-these-subnets
+const azureReservedAddressesPerSubnet = 5
+
+// azureMaximumSubnetPrefixLength is the smallest (IPv4) Subnet which can be created in Azure, a /29
+const azureMaximumSubnetPrefixLength = 29
+
+// parseIPv4CIDR validates the CIDR using the same validation as the Schema and returns the parsed network,
+// the key is the name of the function parameter which is used in any error messages
+func parseIPv4CIDR(input string, key string) (*net.IPNet, error) {
+	if _, errs := validate.CIDR(input, key); len(errs) > 0 {
+		return nil, errs[0]
+	}
+
+	if !strings.Contains(input, "/") {
+		return nil, fmt.Errorf("expected %q to contain a prefix length, for example `10.0.0.0/16` but got %q", key, input)
+	}
+
+	ip, network, err := net.ParseCIDR(input)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q for %q: %+v", input, key, err)
+	}
+
+	if !ip.Equal(network.IP) {
+		return nil, fmt.Errorf("expected %q to be a valid network address but got %q, did you mean %q?", key, input, network.String())
+	}
+
+	return network, nil
+}
+
+// ipv4Range returns the first and last address within the network as integers
+func ipv4Range(network *net.IPNet) (uint32, uint32) {
+	first := binary.BigEndian.Uint32(network.IP.To4())
+	ones, bits := network.Mask.Size()
+	size := uint32(1<<uint(bits-ones)) - 1
+	return first, first + size
+}
+
+func ipv4FromUint32(input uint32) net.IP {
+	ip := make(net.IP, net.IPv4len)
+	binary.BigEndian.PutUint32(ip, input)
+	return ip
+}
+
+func cidrsOverlap(first, second *net.IPNet) bool {
+	firstStart, firstEnd := ipv4Range(first)
+	secondStart, secondEnd := ipv4Range(second)
+	return firstStart <= secondEnd && secondStart <= firstEnd
+}
+
+// usableHostsInSubnet returns the number of addresses within the Subnet which can be assigned to resources,
+// taking into account the addresses which are reserved by Azure within each Subnet
+func usableHostsInSubnet(network *net.IPNet) (int64, error) {
+	ones, bits := network.Mask.Size()
+	if ones > azureMaximumSubnetPrefixLength {
+		return 0, fmt.Errorf("the smallest supported Subnet in Azure is a /%d but got a /%d", azureMaximumSubnetPrefixLength, ones)
+	}
+
+	return (int64(1) << uint(bits-ones)) - azureReservedAddressesPerSubnet, nil
+}
+
+// nextFreeSubnet returns the first Subnet with the specified prefix length which is contained within one of the
+// Address Spaces and which doesn't overlap with any of the Subnets which are already in use
+func nextFreeSubnet(addressSpaces []*net.IPNet, usedSubnets []*net.IPNet, prefixLength int) (*net.IPNet, error) {
+	if prefixLength > azureMaximumSubnetPrefixLength {
+		return nil, fmt.Errorf("the smallest supported Subnet in Azure is a /%d but got a /%d", azureMaximumSubnetPrefixLength, prefixLength)
+	}
+
+	mask := net.CIDRMask(prefixLength, 32)
+	size := uint64(1) << uint(32-prefixLength)
+
+	for _, addressSpace := range addressSpaces {
+		ones, _ := addressSpace.Mask.Size()
+		if prefixLength < ones {
+			continue
+		}
+
+		start, end := ipv4Range(addressSpace)
+		candidate := uint64(start)
+		for candidate+size-1 <= uint64(end) {
+			subnet := &net.IPNet{
+				IP:   ipv4FromUint32(uint32(candidate)),
+				Mask: mask,
+			}
+
+			overlapping := false
+			for _, used := range usedSubnets {
+				if cidrsOverlap(subnet, used) {
+					// rather than stepping through every candidate within the used Subnet, skip to the
+					// first candidate (aligned to the prefix length) after the end of the used Subnet
+					_, usedEnd := ipv4Range(used)
+					candidate = (uint64(usedEnd) + size) / size * size
+					overlapping = true
+					break
+				}
+			}
+
+			if !overlapping {
+				return subnet, nil
+			}
+		}
+	}
+
+	return nil, fmt.Errorf("no free /%d Subnet is available within the Address Space", prefixLength)
+}
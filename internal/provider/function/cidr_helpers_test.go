@@ -0,0 +1,184 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"net"
+	"testing"
+)
+
+func TestParseIPv4CIDR(t *testing.T) {
+	testData := []struct {
+		Input string
+		Valid bool
+	}{
+		{Input: "10.0.0.0/16", Valid: true},
+		{Input: "10.0.1.0/24", Valid: true},
+		{Input: "10.0.1.5/24", Valid: false},
+		{Input: "10.0.0.0", Valid: false},
+		{Input: "10.0.0.0/33", Valid: false},
+		{Input: "2001:db8::/64", Valid: false},
+		{Input: "", Valid: false},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+		_, err := parseIPv4CIDR(v.Input, "cidr")
+		if v.Valid && err != nil {
+			t.Fatalf("expected %q to be valid but got: %+v", v.Input, err)
+		}
+		if !v.Valid && err == nil {
+			t.Fatalf("expected %q to be invalid but it wasn't", v.Input)
+		}
+	}
+}
+
+func TestUsableHostsInSubnet(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected int64
+		Error    bool
+	}{
+		{Input: "10.0.0.0/16", Expected: 65531},
+		{Input: "10.0.1.0/24", Expected: 251},
+		{Input: "10.0.1.0/27", Expected: 27},
+		{Input: "10.0.1.0/29", Expected: 3},
+		{Input: "10.0.1.0/30", Error: true},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+		_, network, err := net.ParseCIDR(v.Input)
+		if err != nil {
+			t.Fatalf("parsing %q: %+v", v.Input, err)
+		}
+
+		actual, err := usableHostsInSubnet(network)
+		if v.Error {
+			if err == nil {
+				t.Fatalf("expected an error for %q but didn't get one", v.Input)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("unexpected error for %q: %+v", v.Input, err)
+		}
+		if actual != v.Expected {
+			t.Fatalf("expected %d usable hosts for %q but got %d", v.Expected, v.Input, actual)
+		}
+	}
+}
+
+func TestCIDRsOverlap(t *testing.T) {
+	testData := []struct {
+		First    string
+		Second   string
+		Expected bool
+	}{
+		{First: "10.0.0.0/16", Second: "10.0.1.0/24", Expected: true},
+		{First: "10.0.1.0/24", Second: "10.0.0.0/16", Expected: true},
+		{First: "10.0.1.0/24", Second: "10.0.2.0/24", Expected: false},
+		{First: "10.0.0.0/23", Second: "10.0.1.0/24", Expected: true},
+		{First: "10.0.0.0/8", Second: "192.168.0.0/16", Expected: false},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q / %q", v.First, v.Second)
+		_, first, _ := net.ParseCIDR(v.First)
+		_, second, _ := net.ParseCIDR(v.Second)
+		if actual := cidrsOverlap(first, second); actual != v.Expected {
+			t.Fatalf("expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
+func TestNextFreeSubnet(t *testing.T) {
+	testData := []struct {
+		AddressSpace []string
+		Used         []string
+		PrefixLength int
+		Expected     string
+		Error        bool
+	}{
+		{
+			AddressSpace: []string{"10.0.0.0/16"},
+			PrefixLength: 24,
+			Expected:     "10.0.0.0/24",
+		},
+		{
+			AddressSpace: []string{"10.0.0.0/16"},
+			Used:         []string{"10.0.0.0/24", "10.0.1.0/25"},
+			PrefixLength: 24,
+			Expected:     "10.0.2.0/24",
+		},
+		{
+			AddressSpace: []string{"10.0.0.0/16"},
+			Used:         []string{"10.0.0.0/24", "10.0.1.0/25"},
+			PrefixLength: 25,
+			Expected:     "10.0.1.128/25",
+		},
+		{
+			AddressSpace: []string{"10.0.0.0/24", "10.1.0.0/24"},
+			Used:         []string{"10.0.0.0/24"},
+			PrefixLength: 26,
+			Expected:     "10.1.0.0/26",
+		},
+		{
+			AddressSpace: []string{"10.0.0.0/24"},
+			Used:         []string{"10.0.0.0/24"},
+			PrefixLength: 26,
+			Error:        true,
+		},
+		{
+			AddressSpace: []string{"10.0.0.0/8"},
+			Used:         []string{"10.0.0.0/9", "10.128.0.0/29", "10.128.0.16/28"},
+			PrefixLength: 29,
+			Expected:     "10.128.0.8/29",
+		},
+		{
+			AddressSpace: []string{"10.0.0.0/8"},
+			Used:         []string{"10.0.0.0/9", "10.128.0.0/9"},
+			PrefixLength: 29,
+			Error:        true,
+		},
+		{
+			AddressSpace: []string{"10.0.0.0/24"},
+			PrefixLength: 16,
+			Error:        true,
+		},
+		{
+			AddressSpace: []string{"10.0.0.0/24"},
+			PrefixLength: 30,
+			Error:        true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %+v", v.AddressSpace)
+		addressSpaces := make([]*net.IPNet, 0)
+		for _, cidr := range v.AddressSpace {
+			_, network, _ := net.ParseCIDR(cidr)
+			addressSpaces = append(addressSpaces, network)
+		}
+		used := make([]*net.IPNet, 0)
+		for _, cidr := range v.Used {
+			_, network, _ := net.ParseCIDR(cidr)
+			used = append(used, network)
+		}
+
+		actual, err := nextFreeSubnet(addressSpaces, used, v.PrefixLength)
+		if v.Error {
+			if err == nil {
+				t.Fatalf("expected an error but got %q", actual.String())
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("unexpected error: %+v", err)
+		}
+		if actual.String() != v.Expected {
+			t.Fatalf("expected %q but got %q", v.Expected, actual.String())
+		}
+	}
+}
@@ -0,0 +1,67 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+type CIDROverlapsFunction struct{}
+
+var _ function.Function = CIDROverlapsFunction{}
+
+func NewCIDROverlapsFunction() function.Function {
+	return &CIDROverlapsFunction{}
+}
+
+func (c CIDROverlapsFunction) Metadata(_ context.Context, _ function.MetadataRequest, response *function.MetadataResponse) {
+	response.Name = "cidr_overlaps"
+}
+
+func (c CIDROverlapsFunction) Definition(_ context.Context, _ function.DefinitionRequest, response *function.DefinitionResponse) {
+	response.Definition = function.Definition{
+		Summary:             "cidr_overlaps",
+		Description:         "Returns whether two IPv4 CIDR ranges contain any of the same addresses",
+		MarkdownDescription: "Returns whether two IPv4 CIDR ranges contain any of the same addresses",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "first",
+				Description:         "The first IPv4 CIDR range",
+				MarkdownDescription: "The first IPv4 CIDR range",
+			},
+			function.StringParameter{
+				Name:                "second",
+				Description:         "The second IPv4 CIDR range",
+				MarkdownDescription: "The second IPv4 CIDR range",
+			},
+		},
+		Return: function.BoolReturn{},
+	}
+}
+
+func (c CIDROverlapsFunction) Run(ctx context.Context, request function.RunRequest, response *function.RunResponse) {
+	var first, second string
+
+	response.Error = function.ConcatFuncErrors(request.Arguments.Get(ctx, &first, &second))
+
+	if response.Error != nil {
+		return
+	}
+
+	firstNetwork, err := parseIPv4CIDR(first, "first")
+	if err != nil {
+		response.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	secondNetwork, err := parseIPv4CIDR(second, "second")
+	if err != nil {
+		response.Error = function.NewArgumentFuncError(1, err.Error())
+		return
+	}
+
+	response.Error = function.ConcatFuncErrors(response.Result.Set(ctx, cidrsOverlap(firstNetwork, secondNetwork)))
+}
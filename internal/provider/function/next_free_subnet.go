@@ -0,0 +1,97 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"fmt"
+	"net"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type NextFreeSubnetFunction struct{}
+
+var _ function.Function = NextFreeSubnetFunction{}
+
+func NewNextFreeSubnetFunction() function.Function {
+	return &NextFreeSubnetFunction{}
+}
+
+func (n NextFreeSubnetFunction) Metadata(_ context.Context, _ function.MetadataRequest, response *function.MetadataResponse) {
+	response.Name = "next_free_subnet"
+}
+
+func (n NextFreeSubnetFunction) Definition(_ context.Context, _ function.DefinitionRequest, response *function.DefinitionResponse) {
+	response.Definition = function.Definition{
+		Summary:             "next_free_subnet",
+		Description:         "Returns the first IPv4 Subnet with the specified prefix length within the Virtual Network Address Space which doesn't overlap an existing Subnet",
+		MarkdownDescription: "Returns the first IPv4 Subnet with the specified prefix length within the Virtual Network Address Space which doesn't overlap an existing Subnet",
+		Parameters: []function.Parameter{
+			function.ListParameter{
+				Name:                "address_space",
+				Description:         "The list of IPv4 CIDR ranges making up the Address Space of the Virtual Network",
+				MarkdownDescription: "The list of IPv4 CIDR ranges making up the Address Space of the Virtual Network",
+				ElementType:         types.StringType,
+			},
+			function.ListParameter{
+				Name:                "used_subnets",
+				Description:         "The list of IPv4 CIDR ranges already allocated to Subnets within the Virtual Network",
+				MarkdownDescription: "The list of IPv4 CIDR ranges already allocated to Subnets within the Virtual Network",
+				ElementType:         types.StringType,
+			},
+			function.Int64Parameter{
+				Name:                "prefix_length",
+				Description:         "The prefix length of the Subnet to allocate, for example `24`",
+				MarkdownDescription: "The prefix length of the Subnet to allocate, for example `24`",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (n NextFreeSubnetFunction) Run(ctx context.Context, request function.RunRequest, response *function.RunResponse) {
+	var addressSpace, usedSubnets []string
+	var prefixLength int64
+
+	response.Error = function.ConcatFuncErrors(request.Arguments.Get(ctx, &addressSpace, &usedSubnets, &prefixLength))
+
+	if response.Error != nil {
+		return
+	}
+
+	if prefixLength < 0 || prefixLength > 32 {
+		response.Error = function.NewArgumentFuncError(2, fmt.Sprintf("expected `prefix_length` to be between 0 and 32 but got %d", prefixLength))
+		return
+	}
+
+	addressSpaceNetworks := make([]*net.IPNet, 0)
+	for _, v := range addressSpace {
+		network, err := parseIPv4CIDR(v, "address_space")
+		if err != nil {
+			response.Error = function.NewArgumentFuncError(0, err.Error())
+			return
+		}
+		addressSpaceNetworks = append(addressSpaceNetworks, network)
+	}
+
+	usedNetworks := make([]*net.IPNet, 0)
+	for _, v := range usedSubnets {
+		network, err := parseIPv4CIDR(v, "used_subnets")
+		if err != nil {
+			response.Error = function.NewArgumentFuncError(1, err.Error())
+			return
+		}
+		usedNetworks = append(usedNetworks, network)
+	}
+
+	result, err := nextFreeSubnet(addressSpaceNetworks, usedNetworks, int(prefixLength))
+	if err != nil {
+		response.Error = function.NewFuncError(err.Error())
+		return
+	}
+
+	response.Error = function.ConcatFuncErrors(response.Result.Set(ctx, result.String()))
+}
@@ -0,0 +1,62 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+type SubnetUsableHostsFunction struct{}
+
+var _ function.Function = SubnetUsableHostsFunction{}
+
+func NewSubnetUsableHostsFunction() function.Function {
+	return &SubnetUsableHostsFunction{}
+}
+
+func (s SubnetUsableHostsFunction) Metadata(_ context.Context, _ function.MetadataRequest, response *function.MetadataResponse) {
+	response.Name = "subnet_usable_hosts"
+}
+
+func (s SubnetUsableHostsFunction) Definition(_ context.Context, _ function.DefinitionRequest, response *function.DefinitionResponse) {
+	response.Definition = function.Definition{
+		Summary:             "subnet_usable_hosts",
+		Description:         "Returns the number of IPv4 addresses within a Subnet which can be assigned to resources, excluding the five addresses reserved by Azure in each Subnet",
+		MarkdownDescription: "Returns the number of IPv4 addresses within a Subnet which can be assigned to resources, excluding the five addresses reserved by Azure in each Subnet",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "cidr",
+				Description:         "The IPv4 CIDR of the Subnet",
+				MarkdownDescription: "The IPv4 CIDR of the Subnet",
+			},
+		},
+		Return: function.Int64Return{},
+	}
+}
+
+func (s SubnetUsableHostsFunction) Run(ctx context.Context, request function.RunRequest, response *function.RunResponse) {
+	var cidr string
+
+	response.Error = function.ConcatFuncErrors(request.Arguments.Get(ctx, &cidr))
+
+	if response.Error != nil {
+		return
+	}
+
+	network, err := parseIPv4CIDR(cidr, "cidr")
+	if err != nil {
+		response.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	result, err := usableHostsInSubnet(network)
+	if err != nil {
+		response.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	response.Error = function.ConcatFuncErrors(response.Result.Set(ctx, result))
+}
@@ -17,9 +17,11 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/resourceproviders"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
@@ -31,6 +33,17 @@ func TestAzureProvider() *schema.Provider {
 	return azureProvider(true)
 }
 
+// TestAzureProviderWithHTTPRecorder returns the Provider used in the Acceptance Tests, which records (or replays) all
+// requests using the HTTPRecorder - and, when specified, authenticates all requests using the Authorizer
+func TestAzureProviderWithHTTPRecorder(recorder common.HTTPRecorder, authorizer auth.Authorizer) *schema.Provider {
+	p := azureProvider(true)
+	p.ConfigureContextFunc = providerConfigure(p, func(builder *clients.ClientBuilder) {
+		builder.Authorizer = authorizer
+		builder.HTTPRecorder = recorder
+	})
+	return p
+}
+
 func ValidatePartnerID(i interface{}, k string) ([]string, []error) {
 	// ValidatePartnerID checks if partner_id is any of the following:
 	//  * a valid UUID - will add "pid-" prefix to the ID if it is not already present
@@ -361,6 +374,141 @@ func azureProvider(supportLegacyTestSuite bool) *schema.Provider {
 				DefaultFunc: schema.EnvDefaultFunc("ARM_STORAGE_USE_AZUREAD", false),
 				Description: "Should the AzureRM Provider use Azure AD Authentication when accessing the Storage Data Plane APIs?",
 			},
+
+			"request_throttling": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Throttles the requests sent to Azure Resource Manager for each Subscription and Resource Provider, based on the number of requests remaining before Azure Resource Manager starts to throttle requests.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"remaining_requests_threshold": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      clients.DefaultRequestThrottlingRemainingRequestsThreshold,
+							ValidateFunc: validation.IntAtLeast(1),
+							Description:  "The number of remaining requests below which requests are queued. Defaults to `100`.",
+						},
+
+						"request_interval_in_milliseconds": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      clients.DefaultRequestThrottlingRequestIntervalInMilliseconds,
+							ValidateFunc: validation.IntAtLeast(1),
+							Description:  "The interval in milliseconds at which queued requests are sent. Defaults to `500`.",
+						},
+					},
+				},
+			},
+
+			"disk_cache": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Caches the Resource Providers and Key Vaults within the Subscription on disk, to avoid listing these on every Terraform run.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"directory": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+							Description:  "The directory in which the cache is stored. Defaults to a `terraform-provider-azurerm` directory within the user's cache directory.",
+						},
+
+						"time_to_live_in_minutes": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      clients.DefaultDiskCacheTimeToLiveInMinutes,
+							ValidateFunc: validation.IntAtLeast(1),
+							Description:  "The number of minutes after which cached items expire. Defaults to `60`.",
+						},
+					},
+				},
+			},
+
+			"structured_logging": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Logs a single line of JSON for each request sent to Azure in place of the full request and response, redacting the values of sensitive fields.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"redacted_fields": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: "A list of additional field names whose values are redacted, in addition to Sensitive attributes and fields ending with `password`, `secret`, `key`, `token`, `connection_string`, `credential` or `sig`.",
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+						},
+					},
+				},
+			},
+
+			"tracing": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Exports OpenTelemetry spans for each operation performed on a Resource and each request sent to Azure, using OTLP over HTTP.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"endpoint": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+							Description:  "The URL of the OTLP/HTTP endpoint which spans are exported to, for example `http://localhost:4318`. Defaults to the endpoint specified in the `OTEL_EXPORTER_OTLP_TRACES_ENDPOINT` or `OTEL_EXPORTER_OTLP_ENDPOINT` environment variables.",
+						},
+					},
+				},
+			},
+
+			"default_timeouts": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Overrides the default timeouts used by Resources, optionally limited to specific Resource Types. Timeouts specified in a Resource's `timeouts` block take precedence over these.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"resource_types": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: "The Resource Types these timeouts apply to, each of which can end with a `*` wildcard (for example `azurerm_kubernetes_*`). When omitted these timeouts apply to all Resources.",
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+						},
+
+						"create": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: ValidateDefaultTimeout,
+							Description:  "The default timeout used when creating Resources, for example `3h`.",
+						},
+
+						"read": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: ValidateDefaultTimeout,
+							Description:  "The default timeout used when reading Resources, for example `10m`.",
+						},
+
+						"update": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: ValidateDefaultTimeout,
+							Description:  "The default timeout used when updating Resources, for example `3h`.",
+						},
+
+						"delete": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: ValidateDefaultTimeout,
+							Description:  "The default timeout used when deleting Resources, for example `3h`.",
+						},
+					},
+				},
+			},
 		},
 
 		DataSourcesMap: dataSources,
@@ -383,7 +531,7 @@ func azureProvider(supportLegacyTestSuite bool) *schema.Provider {
 // providerConfigure is used to configure the cloud environment and authentication.
 // To configure behavioral aspects of the provider, use the buildClient function instead.
 // This separation allows us to robustly test different authentication scenarios.
-func providerConfigure(p *schema.Provider) schema.ConfigureContextFunc {
+func providerConfigure(p *schema.Provider, configureClientBuilder ...func(*clients.ClientBuilder)) schema.ConfigureContextFunc {
 	return func(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
 		subscriptionId := d.Get("subscription_id").(string)
 		if subscriptionId == "" {
@@ -482,13 +630,13 @@ func providerConfigure(p *schema.Provider) schema.ConfigureContextFunc {
 			EnableAuthenticationUsingGitHubOIDC:        enableOidc,
 		}
 
-		return buildClient(ctx, p, d, authConfig)
+		return buildClient(ctx, p, d, authConfig, configureClientBuilder...)
 	}
 }
 
 // buildClient is used to configure behavioral aspects of the provider. To configure the
 // cloud environment and authentication-related settings, use the providerConfigure function.
-func buildClient(ctx context.Context, p *schema.Provider, d *schema.ResourceData, authConfig *auth.Credentials) (*clients.Client, diag.Diagnostics) {
+func buildClient(ctx context.Context, p *schema.Provider, d *schema.ResourceData, authConfig *auth.Credentials, configureClientBuilder ...func(*clients.ClientBuilder)) (*clients.Client, diag.Diagnostics) {
 	// TODO: This hardcoded default is for v3.x, where `resource_provider_registrations` is not defined. Remove this hardcoded default in v4.0
 	providerRegistrations := resourceproviders.ProviderRegistrationsLegacy
 	if features.FourPointOhBeta() {
@@ -516,22 +664,41 @@ func buildClient(ctx context.Context, p *schema.Provider, d *schema.ResourceData
 		requiredResourceProviders.Merge(additionalProvidersToRegister)
 	}
 
+	defaultTimeouts, err := expandDefaultTimeouts(d.Get("default_timeouts").([]interface{}))
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+	timeouts.ApplyDefaultTimeouts(p.ResourcesMap, defaultTimeouts)
+
+	diskCache, err := expandDiskCache(d.Get("disk_cache").([]interface{}))
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+
 	clientBuilder := clients.ClientBuilder{
 		AuthConfig:                  authConfig,
 		DisableCorrelationRequestID: d.Get("disable_correlation_request_id").(bool),
 		DisableTerraformPartnerID:   d.Get("disable_terraform_partner_id").(bool),
+		DiskCache:                   diskCache,
 		Features:                    expandFeatures(d.Get("features").([]interface{})),
 		MetadataHost:                d.Get("metadata_host").(string),
 		PartnerID:                   d.Get("partner_id").(string),
 		RegisteredResourceProviders: requiredResourceProviders,
+		DefaultTimeouts:             defaultTimeouts,
+		RequestThrottling:           expandRequestThrottling(d.Get("request_throttling").([]interface{})),
 		StorageUseAzureAD:           d.Get("storage_use_azuread").(bool),
+		StructuredLogging:           expandStructuredLogging(d.Get("structured_logging").([]interface{}), p),
 		SubscriptionID:              d.Get("subscription_id").(string),
 		TerraformVersion:            p.TerraformVersion,
+		Tracing:                     expandTracing(d.Get("tracing").([]interface{})),
 
 		// this field is intentionally not exposed in the provider block, since it's only used for
 		// platform level tracing
 		CustomCorrelationRequestID: os.Getenv("ARM_CORRELATION_REQUEST_ID"),
 	}
+	for _, configure := range configureClientBuilder {
+		configure(&clientBuilder)
+	}
 
 	//lint:ignore SA1019 SDKv2 migration - staticcheck's own linter directives are currently being ignored under golangci-lint
 	stopCtx, ok := schema.StopContext(ctx) //nolint:staticcheck
@@ -551,7 +718,7 @@ func buildClient(ctx context.Context, p *schema.Provider, d *schema.ResourceData
 	ctx2, cancel := context.WithTimeout(ctx, 30*time.Minute)
 	defer cancel()
 
-	if err = resourceproviders.EnsureRegistered(ctx2, client.Resource.ResourceProvidersClient, subscriptionId, requiredResourceProviders); err != nil {
+	if err = resourceproviders.EnsureRegistered(ctx2, client.Resource.ResourceProvidersClient, subscriptionId, requiredResourceProviders, client.DiskCache); err != nil {
 		return nil, diag.FromErr(err)
 
 	}
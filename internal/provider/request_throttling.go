@@ -0,0 +1,36 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+)
+
+func expandRequestThrottling(input []interface{}) *clients.RequestThrottling {
+	if len(input) == 0 {
+		return nil
+	}
+
+	// an empty block enables request throttling using the default values
+	output := clients.RequestThrottling{
+		RemainingRequestsThreshold: clients.DefaultRequestThrottlingRemainingRequestsThreshold,
+		RequestInterval:            clients.DefaultRequestThrottlingRequestIntervalInMilliseconds * time.Millisecond,
+	}
+
+	if input[0] == nil {
+		return &output
+	}
+
+	raw := input[0].(map[string]interface{})
+	if v, ok := raw["remaining_requests_threshold"].(int); ok && v > 0 {
+		output.RemainingRequestsThreshold = v
+	}
+	if v, ok := raw["request_interval_in_milliseconds"].(int); ok && v > 0 {
+		output.RequestInterval = time.Duration(v) * time.Millisecond
+	}
+
+	return &output
+}
@@ -230,6 +230,16 @@ func SupportedTypedServices() []sdk.TypedServiceRegistration {
 	return services
 }
 
+func SupportedEphemeralServices() []sdk.EphemeralServiceRegistration {
+	return []sdk.EphemeralServiceRegistration{
+		keyvault.Registration{},
+	}
+}
+
+func SupportedFrameworkServices() []sdk.FrameworkServiceRegistration {
+	return []sdk.FrameworkServiceRegistration{}
+}
+
 func SupportedUntypedServices() []sdk.UntypedServiceRegistration {
 	return func() []sdk.UntypedServiceRegistration {
 		out := []sdk.UntypedServiceRegistration{
@@ -104,6 +104,21 @@ func TestResourcesAreNamedConsistently(t *testing.T) {
 		}
 	}
 
+	t.Logf("Validating Framework Services..")
+	for _, service := range SupportedFrameworkServices() {
+		t.Logf("Service %q", service.Name())
+		for _, dataSource := range service.FrameworkDataSources() {
+			if err := validateResourceTypeName(dataSource.ResourceType()); err != nil {
+				t.Fatalf("the Data Source %q isn't named consistently: %+v", dataSource.ResourceType(), err)
+			}
+		}
+		for _, resource := range service.FrameworkResources() {
+			if err := validateResourceTypeName(resource.ResourceType()); err != nil {
+				t.Fatalf("the Resource %q isn't named consistently: %+v", resource.ResourceType(), err)
+			}
+		}
+	}
+
 	t.Logf("Validating Untyped Services..")
 	for _, service := range SupportedUntypedServices() {
 		t.Logf("Service %q", service.Name())
@@ -120,6 +135,25 @@ func TestResourcesAreNamedConsistently(t *testing.T) {
 	}
 }
 
+func TestFrameworkResourcesDoNotConflictWithPluginSDKResources(t *testing.T) {
+	// the Plugin Framework and Plugin SDKv2 Providers are muxed together, so each resource type can only be
+	// implemented by one of them
+	p := AzureProvider()
+	for _, service := range SupportedFrameworkServices() {
+		t.Logf("Service %q", service.Name())
+		for _, dataSource := range service.FrameworkDataSources() {
+			if _, ok := p.DataSourcesMap[dataSource.ResourceType()]; ok {
+				t.Fatalf("the Data Source %q is implemented using both the Plugin Framework and the Plugin SDK", dataSource.ResourceType())
+			}
+		}
+		for _, resource := range service.FrameworkResources() {
+			if _, ok := p.ResourcesMap[resource.ResourceType()]; ok {
+				t.Fatalf("the Resource %q is implemented using both the Plugin Framework and the Plugin SDK", resource.ResourceType())
+			}
+		}
+	}
+}
+
 func validateResourceTypeName(resourceType string) error {
 	if strings.ToLower(resourceType) != resourceType {
 		return fmt.Errorf("the resource type must be all lower-case")
@@ -0,0 +1,72 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+)
+
+func expandStructuredLogging(input []interface{}, p *schema.Provider) *clients.StructuredLogging {
+	if len(input) == 0 {
+		return nil
+	}
+
+	// an empty block enables structured logging, redacting the default and Sensitive fields
+	output := clients.StructuredLogging{
+		RedactedFields:  make([]string, 0),
+		SensitiveFields: SensitiveAttributeNames(p),
+	}
+
+	if input[0] != nil {
+		raw := input[0].(map[string]interface{})
+		for _, v := range raw["redacted_fields"].([]interface{}) {
+			if v, ok := v.(string); ok && v != "" {
+				output.RedactedFields = append(output.RedactedFields, v)
+			}
+		}
+	}
+
+	return &output
+}
+
+// SensitiveAttributeNames returns the (unique) names of the attributes within the Resources and Data Sources of the
+// Provider (including those nested within blocks) which are marked as Sensitive - names which are also used by
+// attributes which aren't Sensitive (such as `name` or `value`) are too generic to be redacted, so are excluded
+func SensitiveAttributeNames(p *schema.Provider) []string {
+	names := make(map[string]bool)
+	for _, resource := range p.ResourcesMap {
+		sensitiveAttributeNames(resource.Schema, names)
+	}
+	for _, dataSource := range p.DataSourcesMap {
+		sensitiveAttributeNames(dataSource.Schema, names)
+	}
+
+	output := make([]string, 0)
+	for name, sensitive := range names {
+		if sensitive {
+			output = append(output, name)
+		}
+	}
+	sort.Strings(output)
+	return output
+}
+
+// sensitiveAttributeNames records whether each attribute name is Sensitive everywhere it's used
+func sensitiveAttributeNames(input map[string]*schema.Schema, names map[string]bool) {
+	for name, item := range input {
+		if v, ok := item.Elem.(*schema.Resource); ok {
+			sensitiveAttributeNames(v.Schema, names)
+			continue
+		}
+
+		if sensitive, exists := names[name]; exists {
+			names[name] = sensitive && item.Sensitive
+		} else {
+			names[name] = item.Sensitive
+		}
+	}
+}
@@ -0,0 +1,26 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+)
+
+func expandTracing(input []interface{}) *clients.Tracing {
+	if len(input) == 0 {
+		return nil
+	}
+
+	// an empty block enables tracing, using the endpoint from the `OTEL_EXPORTER_OTLP_*` environment variables
+	output := clients.Tracing{}
+
+	if input[0] != nil {
+		raw := input[0].(map[string]interface{})
+		if v, ok := raw["endpoint"].(string); ok {
+			output.Endpoint = v
+		}
+	}
+
+	return &output
+}
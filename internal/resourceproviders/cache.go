@@ -6,11 +6,14 @@ package resourceproviders
 import (
 	"context"
 	"fmt"
+	"log"
+	"sort"
 	"strings"
 	"sync"
 
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/resources/2022-09-01/providers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/diskcache"
 )
 
 // cachedResourceProviders can be (validly) nil - as such this shouldn't be relied on
@@ -20,15 +23,24 @@ var unregisteredResourceProviders map[string]struct{}
 
 var cacheLock = &sync.Mutex{}
 
+// diskCacheName is the name of the item used to store the Resource Providers in the diskcache.Cache
+const diskCacheName = "resourceProviders"
+
+// diskCacheItem is the representation of the Resource Providers stored in the diskcache.Cache
+type diskCacheItem struct {
+	Registered   []string `json:"registered"`
+	Unregistered []string `json:"unregistered"`
+}
+
 // CacheSupportedProviders attempts to retrieve the supported Resource Providers from the Resource Manager API
 // and caches them, for used in enhanced validation
-func CacheSupportedProviders(ctx context.Context, client *providers.ProvidersClient, subscriptionId commonids.SubscriptionId) error {
+func CacheSupportedProviders(ctx context.Context, client *providers.ProvidersClient, subscriptionId commonids.SubscriptionId, diskCache *diskcache.Cache) error {
 	// already populated
 	if cachedResourceProviders != nil {
 		return nil
 	}
 
-	if err := populateCache(ctx, client, subscriptionId); err != nil {
+	if err := populateCache(ctx, client, subscriptionId, diskCache); err != nil {
 		return fmt.Errorf("populating cache: %+v", err)
 	}
 
@@ -43,10 +55,28 @@ func ClearCache() {
 	cacheLock.Unlock()
 }
 
-func populateCache(ctx context.Context, client *providers.ProvidersClient, subscriptionId commonids.SubscriptionId) error {
+func populateCache(ctx context.Context, client *providers.ProvidersClient, subscriptionId commonids.SubscriptionId, diskCache *diskcache.Cache) error {
 	cacheLock.Lock()
 	defer cacheLock.Unlock()
 
+	var cached diskCacheItem
+	if diskCache.Get(subscriptionId, diskCacheName, &cached) {
+		providerNames := make([]string, 0)
+		registeredResourceProviders = make(map[string]struct{})
+		unregisteredResourceProviders = make(map[string]struct{})
+		for _, name := range cached.Registered {
+			providerNames = append(providerNames, name)
+			registeredResourceProviders[name] = struct{}{}
+		}
+		for _, name := range cached.Unregistered {
+			providerNames = append(providerNames, name)
+			unregisteredResourceProviders[name] = struct{}{}
+		}
+
+		cachedResourceProviders = &providerNames
+		return nil
+	}
+
 	providers, err := client.ListComplete(ctx, subscriptionId, providers.DefaultListOperationOptions())
 	if err != nil {
 		return fmt.Errorf("listing Resource Providers: %+v", err)
@@ -70,5 +100,47 @@ func populateCache(ctx context.Context, client *providers.ProvidersClient, subsc
 	}
 
 	cachedResourceProviders = &providerNames
+
+	if err := writeDiskCache(subscriptionId, diskCache); err != nil {
+		log.Printf("[DEBUG] Unable to cache the Resource Providers for %s: %+v", subscriptionId, err)
+	}
+
 	return nil
 }
+
+// markAsRegistered updates the cached Resource Providers once the specified Resource Providers have been registered
+func markAsRegistered(subscriptionId commonids.SubscriptionId, diskCache *diskcache.Cache, providerNames []string) {
+	cacheLock.Lock()
+	defer cacheLock.Unlock()
+
+	if registeredResourceProviders == nil || unregisteredResourceProviders == nil {
+		return
+	}
+
+	for _, name := range providerNames {
+		registeredResourceProviders[name] = struct{}{}
+		delete(unregisteredResourceProviders, name)
+	}
+
+	if err := writeDiskCache(subscriptionId, diskCache); err != nil {
+		log.Printf("[DEBUG] Unable to cache the Resource Providers for %s: %+v", subscriptionId, err)
+	}
+}
+
+// writeDiskCache writes the cached Resource Providers to the diskcache.Cache, the caller must hold the cacheLock
+func writeDiskCache(subscriptionId commonids.SubscriptionId, diskCache *diskcache.Cache) error {
+	item := diskCacheItem{
+		Registered:   make([]string, 0),
+		Unregistered: make([]string, 0),
+	}
+	for name := range registeredResourceProviders {
+		item.Registered = append(item.Registered, name)
+	}
+	for name := range unregisteredResourceProviders {
+		item.Unregistered = append(item.Unregistered, name)
+	}
+	sort.Strings(item.Registered)
+	sort.Strings(item.Unregistered)
+
+	return diskCache.Set(subscriptionId, diskCacheName, item)
+}
@@ -0,0 +1,20 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdk
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+)
+
+// EphemeralResourceMetadata is embedded into Ephemeral Resources to provide access to the
+// Client and Provider configuration which is shared with the Plugin SDKv2 Provider
+type EphemeralResourceMetadata struct {
+	FrameworkResourceMetadata
+}
+
+// Defaults configures the EphemeralResourceMetadata from the Provider Data, this should be
+// called from the Configure method of the Ephemeral Resource
+func (r *EphemeralResourceMetadata) Defaults(request ephemeral.ConfigureRequest, response *ephemeral.ConfigureResponse) {
+	r.FrameworkResourceMetadata.Defaults(request.ProviderData, &response.Diagnostics)
+}
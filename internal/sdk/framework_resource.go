@@ -0,0 +1,149 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+// FrameworkResourceMetadata is passed to the functions of Resources and Data Sources implemented natively using the
+// Plugin Framework, providing access to the Client and Provider configuration shared with the Plugin SDKv2 Provider
+type FrameworkResourceMetadata struct {
+	// Client is a reference to the Azure Providers Client - providing a typed reference to this object
+	Client *clients.Client
+
+	SubscriptionId string
+
+	Features features.UserFeatures
+}
+
+// Defaults configures the FrameworkResourceMetadata from the Provider Data - which is the `clients.Client`
+// built by the Plugin SDKv2 Provider
+func (r *FrameworkResourceMetadata) Defaults(providerData any, diags *diag.Diagnostics) {
+	// the Provider Data is nil until the Provider has been configured
+	if providerData == nil {
+		return
+	}
+
+	c, ok := providerData.(*clients.Client)
+	if !ok {
+		diags.AddError("Client Provider Data Error", fmt.Sprintf("expected `*clients.Client` but got %T - Plugin Framework resources are only supported when the Plugin Framework Provider is muxed with the Plugin SDKv2 Provider", providerData))
+		return
+	}
+
+	r.Client = c
+	if c.Account != nil {
+		r.SubscriptionId = c.Account.SubscriptionId
+	}
+	r.Features = c.Features
+}
+
+// FrameworkResourceTimeouts are the default timeouts used for each of the operations of a FrameworkWrappedResource,
+// these can be overridden by users via the `timeouts` block which is added to the Schema of each resource
+type FrameworkResourceTimeouts struct {
+	Create time.Duration
+	Read   time.Duration
+	Update time.Duration
+	Delete time.Duration
+}
+
+var defaultFrameworkResourceTimeouts = FrameworkResourceTimeouts{
+	Create: 30 * time.Minute,
+	Read:   5 * time.Minute,
+	Update: 30 * time.Minute,
+	Delete: 30 * time.Minute,
+}
+
+type frameworkResourceBase interface {
+	// ModelObject is an instance of the object the Schema is decoded/encoded into, this must be a pointer
+	// to a struct using `tfsdk` tags
+	//
+	// NOTE: for Resources this must include a `timeouts.Value` field with the tag `tfsdk:"timeouts"`, since
+	// the wrapper adds a `timeouts` block to the Schema of each Resource
+	ModelObject() any
+
+	// ResourceType is the exposed name of this resource (e.g. `azurerm_example`)
+	ResourceType() string
+}
+
+// FrameworkWrappedResource is a Resource implemented natively using the Plugin Framework, which allows for the use
+// of Plugin Framework features such as Plan Modifiers, Nested Attributes and Write-Only Arguments.
+//
+// The Plan/State is decoded into the ModelObject prior to calling each method, and the ModelObject is then written
+// into the State after each method, so it's not necessary to call `Get` or `Set` on the Plan or State
+type FrameworkWrappedResource interface {
+	frameworkResourceBase
+
+	// Schema returns the Plugin Framework Schema for this Resource
+	//
+	// NOTE: Write-Only attributes require Plugin Framework v1.14.0 or later, as such these can't be used by
+	// Framework Resources until the Plugin Framework has been upgraded
+	Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse)
+
+	// Create will provision this resource using the information from the Terraform Plan, which is available in `plan`
+	Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse, metadata FrameworkResourceMetadata, plan any)
+
+	// Read retrieves the latest values for this object into `state`, calling `response.State.RemoveResource` if
+	// the resource no longer exists
+	Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse, metadata FrameworkResourceMetadata, state any)
+
+	// Delete will remove an existing resource using the information available in `state`
+	Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse, metadata FrameworkResourceMetadata, state any)
+
+	// IDValidationFunc returns the SchemaValidateFunc used to validate the ID is valid during
+	// `terraform import` - ensuring users don't inadvertently specify the incorrect Resource ID
+	IDValidationFunc() pluginsdk.SchemaValidateFunc
+}
+
+// FrameworkWrappedResourceWithUpdate is an optional interface for Resources which can be updated in-place
+type FrameworkWrappedResourceWithUpdate interface {
+	FrameworkWrappedResource
+
+	// Update will make changes to this resource, the planned values are available in `plan` and the
+	// values from the State are available in `state`
+	Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse, metadata FrameworkResourceMetadata, plan any, state any)
+}
+
+// FrameworkWrappedResourceWithModifyPlan is an optional interface for Resources which need to modify the Plan
+type FrameworkWrappedResourceWithModifyPlan interface {
+	FrameworkWrappedResource
+
+	ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse, metadata FrameworkResourceMetadata)
+}
+
+// FrameworkWrappedResourceWithConfigValidators is an optional interface for Resources which validate the
+// Configuration across multiple attributes
+type FrameworkWrappedResourceWithConfigValidators interface {
+	FrameworkWrappedResource
+
+	ConfigValidators(ctx context.Context) []resource.ConfigValidator
+}
+
+// FrameworkWrappedResourceWithTimeouts is an optional interface for Resources which need to override the
+// default timeouts for each operation
+type FrameworkWrappedResourceWithTimeouts interface {
+	FrameworkWrappedResource
+
+	Timeouts() FrameworkResourceTimeouts
+}
+
+// FrameworkWrappedDataSource is a Data Source implemented natively using the Plugin Framework
+type FrameworkWrappedDataSource interface {
+	frameworkResourceBase
+
+	// Schema returns the Plugin Framework Schema for this Data Source
+	Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse)
+
+	// Read looks up the information for this Data Source into `state`, which contains the Configuration
+	Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse, metadata FrameworkResourceMetadata, state any)
+}
@@ -0,0 +1,66 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdk
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+)
+
+var (
+	_ datasource.DataSource              = &FrameworkDataSourceWrapper{}
+	_ datasource.DataSourceWithConfigure = &FrameworkDataSourceWrapper{}
+)
+
+// frameworkDataSourceReadTimeout is the default timeout used when reading a FrameworkWrappedDataSource
+const frameworkDataSourceReadTimeout = 5 * time.Minute
+
+// FrameworkDataSourceWrapper is a wrapper for converting a FrameworkWrappedDataSource implementation
+// into the object used by the Terraform Plugin Framework
+type FrameworkDataSourceWrapper struct {
+	metadata   FrameworkResourceMetadata
+	dataSource FrameworkWrappedDataSource
+}
+
+// NewFrameworkDataSourceWrapper returns a function which instantiates a FrameworkDataSourceWrapper for this
+// FrameworkWrappedDataSource implementation
+func NewFrameworkDataSourceWrapper(d FrameworkWrappedDataSource) func() datasource.DataSource {
+	return func() datasource.DataSource {
+		return &FrameworkDataSourceWrapper{
+			dataSource: d,
+		}
+	}
+}
+
+func (w *FrameworkDataSourceWrapper) Metadata(_ context.Context, _ datasource.MetadataRequest, response *datasource.MetadataResponse) {
+	response.TypeName = w.dataSource.ResourceType()
+}
+
+func (w *FrameworkDataSourceWrapper) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	w.dataSource.Schema(ctx, request, response)
+}
+
+func (w *FrameworkDataSourceWrapper) Configure(_ context.Context, request datasource.ConfigureRequest, response *datasource.ConfigureResponse) {
+	w.metadata.Defaults(request.ProviderData, &response.Diagnostics)
+}
+
+func (w *FrameworkDataSourceWrapper) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	ctx, cancel := context.WithTimeout(ctx, frameworkDataSourceReadTimeout)
+	defer cancel()
+
+	state := w.dataSource.ModelObject()
+	response.Diagnostics.Append(request.Config.Get(ctx, state)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	w.dataSource.Read(ctx, request, response, w.metadata, state)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, state)...)
+}
@@ -0,0 +1,239 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	azurermtimeouts "github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+)
+
+var (
+	_ resource.Resource                     = &FrameworkResourceWrapper{}
+	_ resource.ResourceWithConfigure        = &FrameworkResourceWrapper{}
+	_ resource.ResourceWithImportState      = &FrameworkResourceWrapper{}
+	_ resource.ResourceWithModifyPlan       = &FrameworkResourceWrapper{}
+	_ resource.ResourceWithConfigValidators = &FrameworkResourceWrapper{}
+)
+
+// FrameworkResourceWrapper is a wrapper for converting a FrameworkWrappedResource implementation
+// into the object used by the Terraform Plugin Framework
+type FrameworkResourceWrapper struct {
+	metadata FrameworkResourceMetadata
+	resource FrameworkWrappedResource
+}
+
+// NewFrameworkResourceWrapper returns a function which instantiates a FrameworkResourceWrapper for this
+// FrameworkWrappedResource implementation
+func NewFrameworkResourceWrapper(r FrameworkWrappedResource) func() resource.Resource {
+	return func() resource.Resource {
+		return &FrameworkResourceWrapper{
+			resource: r,
+		}
+	}
+}
+
+func (w *FrameworkResourceWrapper) Metadata(_ context.Context, _ resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = w.resource.ResourceType()
+}
+
+func (w *FrameworkResourceWrapper) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	w.resource.Schema(ctx, request, response)
+
+	// all resources expose a `timeouts` block, matching the Plugin SDKv2 resources
+	if response.Schema.Blocks == nil {
+		response.Schema.Blocks = make(map[string]schema.Block)
+	}
+	if _, ok := response.Schema.Blocks["timeouts"]; !ok {
+		_, supportsUpdate := w.resource.(FrameworkWrappedResourceWithUpdate)
+		response.Schema.Blocks["timeouts"] = timeouts.Block(ctx, timeouts.Opts{
+			Create: true,
+			Read:   true,
+			Update: supportsUpdate,
+			Delete: true,
+		})
+	}
+}
+
+func (w *FrameworkResourceWrapper) Configure(_ context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
+	w.metadata.Defaults(request.ProviderData, &response.Diagnostics)
+}
+
+func (w *FrameworkResourceWrapper) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	createTimeout, diags := configuredTimeouts(ctx, request.Plan.GetAttribute, &response.Diagnostics).Create(ctx, w.timeouts().Create)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	plan := w.resource.ModelObject()
+	response.Diagnostics.Append(request.Plan.Get(ctx, plan)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	w.resource.Create(ctx, request, response, w.metadata, plan)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, plan)...)
+}
+
+func (w *FrameworkResourceWrapper) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	readTimeout, diags := configuredTimeouts(ctx, request.State.GetAttribute, &response.Diagnostics).Read(ctx, w.timeouts().Read)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	state := w.resource.ModelObject()
+	response.Diagnostics.Append(request.State.Get(ctx, state)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	w.resource.Read(ctx, request, response, w.metadata, state)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// the resource has been marked as gone, so there's nothing to update
+	if response.State.Raw.IsNull() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, state)...)
+}
+
+func (w *FrameworkResourceWrapper) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	r, ok := w.resource.(FrameworkWrappedResourceWithUpdate)
+	if !ok {
+		response.Diagnostics.AddError("Update not supported", fmt.Sprintf("%s doesn't support being updated in-place - all arguments should be marked as requiring replacement", w.resource.ResourceType()))
+		return
+	}
+
+	updateTimeout, diags := configuredTimeouts(ctx, request.Plan.GetAttribute, &response.Diagnostics).Update(ctx, w.timeouts().Update)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	plan := w.resource.ModelObject()
+	response.Diagnostics.Append(request.Plan.Get(ctx, plan)...)
+	state := w.resource.ModelObject()
+	response.Diagnostics.Append(request.State.Get(ctx, state)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	r.Update(ctx, request, response, w.metadata, plan, state)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, plan)...)
+}
+
+func (w *FrameworkResourceWrapper) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	deleteTimeout, diags := configuredTimeouts(ctx, request.State.GetAttribute, &response.Diagnostics).Delete(ctx, w.timeouts().Delete)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	state := w.resource.ModelObject()
+	response.Diagnostics.Append(request.State.Get(ctx, state)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	w.resource.Delete(ctx, request, response, w.metadata, state)
+}
+
+func (w *FrameworkResourceWrapper) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	if validateFunc := w.resource.IDValidationFunc(); validateFunc != nil {
+		if _, errs := validateFunc(request.ID, "id"); len(errs) > 0 {
+			for _, err := range errs {
+				response.Diagnostics.AddError("parsing Resource ID", err.Error())
+			}
+			return
+		}
+	}
+
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), request, response)
+}
+
+func (w *FrameworkResourceWrapper) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	if r, ok := w.resource.(FrameworkWrappedResourceWithModifyPlan); ok {
+		r.ModifyPlan(ctx, request, response, w.metadata)
+	}
+}
+
+func (w *FrameworkResourceWrapper) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	if r, ok := w.resource.(FrameworkWrappedResourceWithConfigValidators); ok {
+		return r.ConfigValidators(ctx)
+	}
+
+	return nil
+}
+
+// configuredTimeouts returns the `timeouts` block from the Plan or State, which is null when the user hasn't
+// specified any timeouts - in which case the default timeouts are used
+func configuredTimeouts(ctx context.Context, getAttribute func(context.Context, path.Path, any) diag.Diagnostics, diags *diag.Diagnostics) timeouts.Value {
+	var value timeouts.Value
+	diags.Append(getAttribute(ctx, path.Root("timeouts"), &value)...)
+	return value
+}
+
+// timeouts returns the default timeouts for this resource, which can be overridden by the user via the `timeouts` block
+func (w *FrameworkResourceWrapper) timeouts() FrameworkResourceTimeouts {
+	timeouts := defaultFrameworkResourceTimeouts
+	if r, ok := w.resource.(FrameworkWrappedResourceWithTimeouts); ok {
+		override := r.Timeouts()
+		timeouts.Create = durationOrDefault(override.Create, timeouts.Create)
+		timeouts.Read = durationOrDefault(override.Read, timeouts.Read)
+		timeouts.Update = durationOrDefault(override.Update, timeouts.Update)
+		timeouts.Delete = durationOrDefault(override.Delete, timeouts.Delete)
+	}
+
+	// the default timeouts configured in the Provider block take precedence over the Resource's own defaults
+	if w.metadata.Client != nil {
+		override := azurermtimeouts.DefaultTimeoutsForResourceType(w.metadata.Client.DefaultTimeouts, w.resource.ResourceType())
+		timeouts.Create = durationOrDefault(pointer.From(override.Create), timeouts.Create)
+		timeouts.Read = durationOrDefault(pointer.From(override.Read), timeouts.Read)
+		timeouts.Update = durationOrDefault(pointer.From(override.Update), timeouts.Update)
+		timeouts.Delete = durationOrDefault(pointer.From(override.Delete), timeouts.Delete)
+	}
+
+	return timeouts
+}
+
+func durationOrDefault(input, defaultValue time.Duration) time.Duration {
+	if input == 0 {
+		return defaultValue
+	}
+
+	return input
+}
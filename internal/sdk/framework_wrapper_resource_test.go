@@ -0,0 +1,344 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	azurermtimeouts "github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+)
+
+type frameworkTestResource struct{}
+
+func (frameworkTestResource) ModelObject() any {
+	return &struct{}{}
+}
+
+func (frameworkTestResource) ResourceType() string {
+	return "azurerm_framework_test"
+}
+
+func (frameworkTestResource) Schema(_ context.Context, _ resource.SchemaRequest, _ *resource.SchemaResponse) {
+}
+
+func (frameworkTestResource) Create(_ context.Context, _ resource.CreateRequest, _ *resource.CreateResponse, _ FrameworkResourceMetadata, _ any) {
+}
+
+func (frameworkTestResource) Read(_ context.Context, _ resource.ReadRequest, _ *resource.ReadResponse, _ FrameworkResourceMetadata, _ any) {
+}
+
+func (frameworkTestResource) Delete(_ context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse, _ FrameworkResourceMetadata, _ any) {
+}
+
+func (frameworkTestResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return func(input interface{}, key string) ([]string, []error) {
+		return nil, []error{fmt.Errorf("%q is not a valid ID", input)}
+	}
+}
+
+type frameworkTestResourceWithTimeouts struct {
+	frameworkTestResource
+}
+
+func (frameworkTestResourceWithTimeouts) Timeouts() FrameworkResourceTimeouts {
+	return FrameworkResourceTimeouts{
+		Create: 90 * time.Minute,
+	}
+}
+
+func TestFrameworkResourceWrapperTimeouts(t *testing.T) {
+	wrapper := NewFrameworkResourceWrapper(frameworkTestResource{})().(*FrameworkResourceWrapper)
+	if actual := wrapper.timeouts(); actual != defaultFrameworkResourceTimeouts {
+		t.Fatalf("expected the default timeouts %+v but got %+v", defaultFrameworkResourceTimeouts, actual)
+	}
+
+	wrapper = NewFrameworkResourceWrapper(frameworkTestResourceWithTimeouts{})().(*FrameworkResourceWrapper)
+	actual := wrapper.timeouts()
+	if actual.Create != 90*time.Minute {
+		t.Fatalf("expected the Create timeout to be overridden to 90m but got %s", actual.Create)
+	}
+	if actual.Read != defaultFrameworkResourceTimeouts.Read {
+		t.Fatalf("expected the Read timeout to use the default %s but got %s", defaultFrameworkResourceTimeouts.Read, actual.Read)
+	}
+
+	// the default timeouts configured in the Provider block take precedence over the Resource's own defaults
+	wrapper.metadata.Client = &clients.Client{
+		DefaultTimeouts: []azurermtimeouts.DefaultTimeouts{
+			{
+				ResourceTypes: []string{"azurerm_framework_*"},
+				Create:        pointer.To(3 * time.Hour),
+			},
+			{
+				ResourceTypes: []string{"azurerm_other"},
+				Read:          pointer.To(time.Hour),
+			},
+		},
+	}
+	actual = wrapper.timeouts()
+	if actual.Create != 3*time.Hour {
+		t.Fatalf("expected the Create timeout to be overridden by the Provider to 3h but got %s", actual.Create)
+	}
+	if actual.Read != defaultFrameworkResourceTimeouts.Read {
+		t.Fatalf("expected the Read timeout to use the default %s but got %s", defaultFrameworkResourceTimeouts.Read, actual.Read)
+	}
+}
+
+func TestFrameworkResourceWrapperImportValidatesID(t *testing.T) {
+	wrapper := NewFrameworkResourceWrapper(frameworkTestResource{})()
+	response := &resource.ImportStateResponse{}
+	wrapper.(resource.ResourceWithImportState).ImportState(context.Background(), resource.ImportStateRequest{ID: "invalid"}, response)
+	if !response.Diagnostics.HasError() {
+		t.Fatalf("expected an error when importing an invalid ID but didn't get one")
+	}
+}
+
+func TestFrameworkResourceMetadataDefaults(t *testing.T) {
+	metadata := FrameworkResourceMetadata{}
+	response := &resource.ConfigureResponse{}
+
+	// the Provider Data is nil until the Provider has been configured
+	metadata.Defaults(nil, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		t.Fatalf("expected no error when the Provider Data is nil but got: %+v", response.Diagnostics)
+	}
+
+	metadata.Defaults("not a client", &response.Diagnostics)
+	if !response.Diagnostics.HasError() {
+		t.Fatalf("expected an error when the Provider Data isn't a `*clients.Client` but didn't get one")
+	}
+}
+
+type frameworkTestCRUDResourceModel struct {
+	ID       types.String   `tfsdk:"id"`
+	Name     types.String   `tfsdk:"name"`
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+// frameworkTestCRUDResource records the values passed to it by the FrameworkResourceWrapper
+type frameworkTestCRUDResource struct {
+	frameworkTestResource
+
+	removed bool
+
+	createdName string
+	deadline    time.Duration
+	updatedFrom string
+	updatedTo   string
+}
+
+func (r *frameworkTestCRUDResource) ModelObject() any {
+	return &frameworkTestCRUDResourceModel{}
+}
+
+func (r *frameworkTestCRUDResource) Schema(_ context.Context, _ resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"name": schema.StringAttribute{
+				Required: true,
+			},
+		},
+	}
+}
+
+func (r *frameworkTestCRUDResource) Create(ctx context.Context, _ resource.CreateRequest, _ *resource.CreateResponse, _ FrameworkResourceMetadata, plan any) {
+	model := plan.(*frameworkTestCRUDResourceModel)
+	r.createdName = model.Name.ValueString()
+	if deadline, ok := ctx.Deadline(); ok {
+		r.deadline = time.Until(deadline)
+	}
+
+	model.ID = types.StringValue("/example/" + model.Name.ValueString())
+}
+
+func (r *frameworkTestCRUDResource) Read(ctx context.Context, _ resource.ReadRequest, response *resource.ReadResponse, _ FrameworkResourceMetadata, state any) {
+	if r.removed {
+		response.State.RemoveResource(ctx)
+		return
+	}
+
+	model := state.(*frameworkTestCRUDResourceModel)
+	model.Name = types.StringValue("refreshed")
+}
+
+func (r *frameworkTestCRUDResource) Update(_ context.Context, _ resource.UpdateRequest, _ *resource.UpdateResponse, _ FrameworkResourceMetadata, plan any, state any) {
+	r.updatedFrom = state.(*frameworkTestCRUDResourceModel).Name.ValueString()
+	r.updatedTo = plan.(*frameworkTestCRUDResourceModel).Name.ValueString()
+}
+
+func frameworkTestCRUDResourceData(t *testing.T, wrapper resource.Resource, id tftypes.Value, name string, createTimeout *string) (schema.Schema, tftypes.Value) {
+	ctx := context.Background()
+	response := &resource.SchemaResponse{}
+	wrapper.Schema(ctx, resource.SchemaRequest{}, response)
+	if response.Diagnostics.HasError() {
+		t.Fatalf("building Schema: %+v", response.Diagnostics)
+	}
+
+	objectType := response.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	timeoutsType := objectType.AttributeTypes["timeouts"]
+	timeoutsValue := tftypes.NewValue(timeoutsType, nil)
+	if createTimeout != nil {
+		values := make(map[string]tftypes.Value)
+		for k, v := range timeoutsType.(tftypes.Object).AttributeTypes {
+			values[k] = tftypes.NewValue(v, nil)
+		}
+		values["create"] = tftypes.NewValue(tftypes.String, *createTimeout)
+		timeoutsValue = tftypes.NewValue(timeoutsType, values)
+	}
+
+	return response.Schema, tftypes.NewValue(objectType, map[string]tftypes.Value{
+		"id":       id,
+		"name":     tftypes.NewValue(tftypes.String, name),
+		"timeouts": timeoutsValue,
+	})
+}
+
+func TestFrameworkResourceWrapperCreate(t *testing.T) {
+	ctx := context.Background()
+	fake := &frameworkTestCRUDResource{}
+	wrapper := NewFrameworkResourceWrapper(fake)()
+
+	createTimeout := "90m"
+	resourceSchema, raw := frameworkTestCRUDResourceData(t, wrapper, tftypes.NewValue(tftypes.String, tftypes.UnknownValue), "example", &createTimeout)
+	response := &resource.CreateResponse{
+		State: tfsdk.State{
+			Schema: resourceSchema,
+			Raw:    tftypes.NewValue(raw.Type(), nil),
+		},
+	}
+	wrapper.Create(ctx, resource.CreateRequest{Plan: tfsdk.Plan{Schema: resourceSchema, Raw: raw}}, response)
+	if response.Diagnostics.HasError() {
+		t.Fatalf("creating: %+v", response.Diagnostics)
+	}
+
+	if fake.createdName != "example" {
+		t.Fatalf("expected the Plan to be decoded into the ModelObject with the name %q but got %q", "example", fake.createdName)
+	}
+	if fake.deadline <= defaultFrameworkResourceTimeouts.Create {
+		t.Fatalf("expected the user-specified Create timeout of 90m to be used but got %s", fake.deadline)
+	}
+
+	var id types.String
+	response.Diagnostics.Append(response.State.GetAttribute(ctx, path.Root("id"), &id)...)
+	if response.Diagnostics.HasError() {
+		t.Fatalf("retrieving `id` from the State: %+v", response.Diagnostics)
+	}
+	if id.ValueString() != "/example/example" {
+		t.Fatalf("expected the ModelObject to be written into the State with the ID %q but got %q", "/example/example", id.ValueString())
+	}
+}
+
+func TestFrameworkResourceWrapperRead(t *testing.T) {
+	ctx := context.Background()
+	fake := &frameworkTestCRUDResource{}
+	wrapper := NewFrameworkResourceWrapper(fake)()
+
+	resourceSchema, raw := frameworkTestCRUDResourceData(t, wrapper, tftypes.NewValue(tftypes.String, "/example/example"), "example", nil)
+	state := tfsdk.State{Schema: resourceSchema, Raw: raw}
+	response := &resource.ReadResponse{State: state}
+	wrapper.Read(ctx, resource.ReadRequest{State: state}, response)
+	if response.Diagnostics.HasError() {
+		t.Fatalf("reading: %+v", response.Diagnostics)
+	}
+
+	var name types.String
+	response.Diagnostics.Append(response.State.GetAttribute(ctx, path.Root("name"), &name)...)
+	if response.Diagnostics.HasError() {
+		t.Fatalf("retrieving `name` from the State: %+v", response.Diagnostics)
+	}
+	if name.ValueString() != "refreshed" {
+		t.Fatalf("expected the ModelObject to be written into the State with the name %q but got %q", "refreshed", name.ValueString())
+	}
+}
+
+func TestFrameworkResourceWrapperReadRemoved(t *testing.T) {
+	ctx := context.Background()
+	fake := &frameworkTestCRUDResource{
+		removed: true,
+	}
+	wrapper := NewFrameworkResourceWrapper(fake)()
+
+	resourceSchema, raw := frameworkTestCRUDResourceData(t, wrapper, tftypes.NewValue(tftypes.String, "/example/example"), "example", nil)
+	state := tfsdk.State{Schema: resourceSchema, Raw: raw}
+	response := &resource.ReadResponse{State: state}
+	wrapper.Read(ctx, resource.ReadRequest{State: state}, response)
+	if response.Diagnostics.HasError() {
+		t.Fatalf("reading: %+v", response.Diagnostics)
+	}
+
+	if !response.State.Raw.IsNull() {
+		t.Fatalf("expected the State to remain removed but got %s", response.State.Raw.String())
+	}
+}
+
+func TestFrameworkResourceWrapperUpdate(t *testing.T) {
+	ctx := context.Background()
+	fake := &frameworkTestCRUDResource{}
+	wrapper := NewFrameworkResourceWrapper(fake)()
+
+	id := tftypes.NewValue(tftypes.String, "/example/example")
+	resourceSchema, planRaw := frameworkTestCRUDResourceData(t, wrapper, id, "updated", nil)
+	_, stateRaw := frameworkTestCRUDResourceData(t, wrapper, id, "example", nil)
+	response := &resource.UpdateResponse{
+		State: tfsdk.State{Schema: resourceSchema, Raw: stateRaw},
+	}
+	request := resource.UpdateRequest{
+		Plan:  tfsdk.Plan{Schema: resourceSchema, Raw: planRaw},
+		State: tfsdk.State{Schema: resourceSchema, Raw: stateRaw},
+	}
+	wrapper.Update(ctx, request, response)
+	if response.Diagnostics.HasError() {
+		t.Fatalf("updating: %+v", response.Diagnostics)
+	}
+
+	if fake.updatedFrom != "example" || fake.updatedTo != "updated" {
+		t.Fatalf("expected the State (%q) and Plan (%q) to be decoded but got %q and %q", "example", "updated", fake.updatedFrom, fake.updatedTo)
+	}
+
+	var name types.String
+	response.Diagnostics.Append(response.State.GetAttribute(ctx, path.Root("name"), &name)...)
+	if response.Diagnostics.HasError() {
+		t.Fatalf("retrieving `name` from the State: %+v", response.Diagnostics)
+	}
+	if name.ValueString() != "updated" {
+		t.Fatalf("expected the Plan to be written into the State with the name %q but got %q", "updated", name.ValueString())
+	}
+}
+
+func TestFrameworkResourceWrapperSchemaTimeouts(t *testing.T) {
+	ctx := context.Background()
+	for _, v := range []struct {
+		resource       FrameworkWrappedResource
+		expectedUpdate bool
+	}{
+		{resource: frameworkTestResource{}, expectedUpdate: false},
+		{resource: &frameworkTestCRUDResource{}, expectedUpdate: true},
+	} {
+		response := &resource.SchemaResponse{}
+		NewFrameworkResourceWrapper(v.resource)().Schema(ctx, resource.SchemaRequest{}, response)
+
+		block, ok := response.Schema.Blocks["timeouts"]
+		if !ok {
+			t.Fatalf("expected a `timeouts` block to be added to the Schema of %T", v.resource)
+		}
+		if _, ok := block.GetNestedObject().GetAttributes()["update"]; ok != v.expectedUpdate {
+			t.Fatalf("expected the `update` timeout to be present (%t) for %T but got %t", v.expectedUpdate, v.resource, ok)
+		}
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
@@ -156,6 +157,18 @@ type ResourceWithCustomizeDiff interface {
 	CustomizeDiff() ResourceFunc
 }
 
+// ResourceWithRawConfigValidation is an optional interface
+//
+// Resources implementing this interface can validate the Raw Configuration, which (unlike the
+// Plan or State) contains the values of any Write-Only arguments
+type ResourceWithRawConfigValidation interface {
+	Resource
+
+	// ValidateRawResourceConfigFuncs returns a list of functions used to validate the Raw Configuration
+	// for example `validation.PreferWriteOnlyAttribute`
+	ValidateRawResourceConfigFuncs() []schema.ValidateRawResourceConfigFunc
+}
+
 // ResourceRunFunc is the function which can be run
 // ctx provides a Context instance with the user-provided timeout
 // metadata is a reference to an object containing the Client, ResourceData and a Logger
@@ -199,6 +212,12 @@ func (rmd ResourceMetaData) MarkAsGone(idFormatter resourceids.Id) error {
 	return nil
 }
 
+// GetWriteOnly returns the value of the Write-Only argument `name` from the Raw Configuration - since
+// Write-Only arguments are never persisted into the Plan or State these can't be retrieved using Decode
+func (rmd ResourceMetaData) GetWriteOnly(name string, attributeType cty.Type) (*cty.Value, error) {
+	return pluginsdk.GetWriteOnly(rmd.ResourceData, name, attributeType)
+}
+
 // ResourceRequiresImport returns an error saying that this resource must be imported with instructions
 // on how to do this (namely, using `terraform import`
 func (rmd ResourceMetaData) ResourceRequiresImport(resourceName string, idFormatter resourceids.Id) error {
@@ -4,6 +4,7 @@
 package sdk
 
 import (
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 )
 
@@ -24,6 +25,29 @@ type TypedServiceRegistration interface {
 	WebsiteCategories() []string
 }
 
+// EphemeralServiceRegistration is a Service Registration for Ephemeral Resources, which are
+// implemented using the Plugin Framework and whose values are never persisted into the state
+type EphemeralServiceRegistration interface {
+	// Name is the name of this Service
+	Name() string
+
+	// EphemeralResources returns a list of Ephemeral Resources supported by this Service
+	EphemeralResources() []func() ephemeral.EphemeralResource
+}
+
+// FrameworkServiceRegistration is a Service Registration for Resources and Data Sources implemented
+// natively using the Plugin Framework, which share the Client built by the Plugin SDKv2 Provider
+type FrameworkServiceRegistration interface {
+	// Name is the name of this Service
+	Name() string
+
+	// FrameworkDataSources returns a list of Plugin Framework Data Sources supported by this Service
+	FrameworkDataSources() []FrameworkWrappedDataSource
+
+	// FrameworkResources returns a list of Plugin Framework Resources supported by this Service
+	FrameworkResources() []FrameworkWrappedResource
+}
+
 // UntypedServiceRegistration is the interface used for untyped/raw Plugin SDK resources
 // in the future this'll be superseded by the TypedServiceRegistration which allows for
 // stronger Typed resources to be used.
@@ -50,7 +50,7 @@ func (rw *ResourceWrapper) Resource() (*schema.Resource, error) {
 	resource := schema.Resource{
 		Schema: *resourceSchema,
 
-		CreateContext: rw.diagnosticsWrapper(func(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+		CreateContext: rw.diagnosticsWrapper(rw.tracingWrapper("create", func(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 			metaData := runArgs(d, meta, rw.logger)
 			err := rw.resource.Create().Func(ctx, metaData)
 			if err != nil {
@@ -60,17 +60,17 @@ func (rw *ResourceWrapper) Resource() (*schema.Resource, error) {
 			// functions timeout here, we're still /technically/ in the
 			// Create function so reusing that timeout should be sufficient
 			return rw.resource.Read().Func(ctx, metaData)
-		}),
+		})),
 
 		// looks like these could be reused, easiest if they're not
-		ReadContext: rw.diagnosticsWrapper(func(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+		ReadContext: rw.diagnosticsWrapper(rw.tracingWrapper("read", func(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 			metaData := runArgs(d, meta, rw.logger)
 			return rw.resource.Read().Func(ctx, metaData)
-		}),
-		DeleteContext: rw.diagnosticsWrapper(func(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+		})),
+		DeleteContext: rw.diagnosticsWrapper(rw.tracingWrapper("delete", func(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 			metaData := runArgs(d, meta, rw.logger)
 			return rw.resource.Delete().Func(ctx, metaData)
-		}),
+		})),
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: d(rw.resource.Create().Timeout),
@@ -115,7 +115,7 @@ func (rw *ResourceWrapper) Resource() (*schema.Resource, error) {
 	// Not all resources support update - so this is an separate interface
 	// implementations can opt to interface
 	if v, ok := rw.resource.(ResourceWithUpdate); ok {
-		resource.UpdateContext = rw.diagnosticsWrapper(func(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+		resource.UpdateContext = rw.diagnosticsWrapper(rw.tracingWrapper("update", func(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 			metaData := runArgs(d, meta, rw.logger)
 
 			err := v.Update().Func(ctx, metaData)
@@ -126,7 +126,7 @@ func (rw *ResourceWrapper) Resource() (*schema.Resource, error) {
 			// we're still "technically" in the update method, so reusing the
 			// Update's timeout should be fine
 			return rw.resource.Read().Func(ctx, metaData)
-		})
+		}))
 		resource.Timeouts.Update = d(v.Update().Timeout)
 	}
 
@@ -146,6 +146,10 @@ func (rw *ResourceWrapper) Resource() (*schema.Resource, error) {
 		}
 	}
 
+	if v, ok := rw.resource.(ResourceWithRawConfigValidation); ok {
+		resource.ValidateRawResourceConfigFuncs = v.ValidateRawResourceConfigFuncs()
+	}
+
 	if v, ok := rw.resource.(ResourceWithDeprecationAndNoReplacement); ok {
 		message := v.DeprecationMessage()
 		if message == "" {
@@ -186,6 +190,21 @@ func (rw *ResourceWrapper) diagnosticsWrapper(in func(ctx context.Context, d *sc
 	return diagnosticsWrapper(in, rw.logger)
 }
 
+// tracingWrapper emits an OpenTelemetry span for the operation when tracing is enabled in the Provider block
+func (rw *ResourceWrapper) tracingWrapper(operation string, in func(ctx context.Context, d *schema.ResourceData, meta interface{}) error) func(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+		client, ok := meta.(*clients.Client)
+		if !ok || client.Tracer == nil {
+			return in(ctx, d, meta)
+		}
+
+		ctx, op := client.Tracer.StartOperation(ctx, rw.resource.ResourceType(), operation)
+		err := in(ctx, d, meta)
+		op.End(ctx, d.Id(), err)
+		return err
+	}
+}
+
 func diagnosticsWrapper(in func(ctx context.Context, d *schema.ResourceData, meta interface{}) error, logger Logger) func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 		out := make([]diag.Diagnostic, 0)
@@ -0,0 +1,172 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdk
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+var (
+	_ ResourceWithRawConfigValidation = writeOnlyTestResource{}
+	_ ResourceWithUpdate              = writeOnlyTestResource{}
+)
+
+type writeOnlyTestResource struct{}
+
+type writeOnlyTestResourceModel struct {
+	Name          string `tfschema:"name"`
+	SecretVersion int    `tfschema:"secret_wo_version"`
+}
+
+func (writeOnlyTestResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+		},
+
+		"secret_wo": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			WriteOnly:    true,
+			Sensitive:    true,
+			RequiredWith: []string{"secret_wo_version"},
+		},
+
+		"secret_wo_version": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			RequiredWith: []string{"secret_wo"},
+		},
+	}
+}
+
+func (writeOnlyTestResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (writeOnlyTestResource) ModelObject() interface{} {
+	return &writeOnlyTestResourceModel{}
+}
+
+func (writeOnlyTestResource) ResourceType() string {
+	return "azurerm_write_only_test"
+}
+
+func (writeOnlyTestResource) Create() ResourceFunc {
+	return ResourceFunc{}
+}
+
+func (writeOnlyTestResource) Read() ResourceFunc {
+	return ResourceFunc{}
+}
+
+func (writeOnlyTestResource) Update() ResourceFunc {
+	return ResourceFunc{}
+}
+
+func (writeOnlyTestResource) Delete() ResourceFunc {
+	return ResourceFunc{}
+}
+
+func (writeOnlyTestResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return nil
+}
+
+func (writeOnlyTestResource) ValidateRawResourceConfigFuncs() []schema.ValidateRawResourceConfigFunc {
+	return []schema.ValidateRawResourceConfigFunc{
+		func(ctx context.Context, req schema.ValidateResourceConfigFuncRequest, resp *schema.ValidateResourceConfigFuncResponse) {
+			if req.RawConfig.GetAttr("secret_wo").IsNull() {
+				resp.Diagnostics = append(resp.Diagnostics, diag.Diagnostic{
+					Severity: diag.Warning,
+					Summary:  "`secret_wo` should be specified",
+				})
+			}
+		},
+	}
+}
+
+func TestResourceWrapperRawConfigValidation(t *testing.T) {
+	wrapper := NewResourceWrapper(writeOnlyTestResource{})
+	resource, err := wrapper.Resource()
+	if err != nil {
+		t.Fatalf("building Resource: %+v", err)
+	}
+
+	if err := resource.InternalValidate(nil, true); err != nil {
+		t.Fatalf("validating Resource: %+v", err)
+	}
+
+	if len(resource.ValidateRawResourceConfigFuncs) != 1 {
+		t.Fatalf("expected 1 ValidateRawResourceConfigFunc but got %d", len(resource.ValidateRawResourceConfigFuncs))
+	}
+
+	if !resource.SchemaMap()["secret_wo"].WriteOnly {
+		t.Fatalf("expected `secret_wo` to be Write-Only")
+	}
+}
+
+func TestResourceMetaDataGetWriteOnly(t *testing.T) {
+	wrapper := NewResourceWrapper(writeOnlyTestResource{})
+	resource, err := wrapper.Resource()
+	if err != nil {
+		t.Fatalf("building Resource: %+v", err)
+	}
+
+	testData := []struct {
+		name      string
+		rawConfig cty.Value
+		expected  cty.Value
+	}{
+		{
+			name: "specified",
+			rawConfig: cty.ObjectVal(map[string]cty.Value{
+				"name":              cty.StringVal("example"),
+				"secret_wo":         cty.StringVal("s3cr3t"),
+				"secret_wo_version": cty.NumberIntVal(1),
+			}),
+			expected: cty.StringVal("s3cr3t"),
+		},
+		{
+			name: "omitted",
+			rawConfig: cty.ObjectVal(map[string]cty.Value{
+				"name":              cty.StringVal("example"),
+				"secret_wo":         cty.NullVal(cty.String),
+				"secret_wo_version": cty.NullVal(cty.Number),
+			}),
+			expected: cty.NullVal(cty.String),
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.name)
+
+		metadata := ResourceMetaData{
+			ResourceData: resource.Data(&terraform.InstanceState{
+				ID:        "example",
+				RawConfig: v.rawConfig,
+			}),
+		}
+
+		actual, err := metadata.GetWriteOnly("secret_wo", cty.String)
+		if err != nil {
+			t.Fatalf("retrieving `secret_wo`: %+v", err)
+		}
+		if !actual.RawEquals(v.expected) {
+			t.Fatalf("expected %#v but got %#v", v.expected, *actual)
+		}
+
+		if _, err := metadata.GetWriteOnly("secret_wo", cty.Number); err == nil {
+			t.Fatalf("expected an error when retrieving `secret_wo` as a Number but didn't get one")
+		}
+	}
+}
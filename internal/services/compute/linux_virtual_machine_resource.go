@@ -101,6 +101,26 @@ func resourceLinuxVirtualMachine() *pluginsdk.Resource {
 				Sensitive:        true,
 				DiffSuppressFunc: adminPasswordDiffSuppressFunc,
 				ValidateFunc:     computeValidate.LinuxAdminPassword,
+				ConflictsWith:    []string{"admin_password_wo"},
+			},
+
+			"admin_password_wo": {
+				Type:          pluginsdk.TypeString,
+				Optional:      true,
+				WriteOnly:     true,
+				Sensitive:     true,
+				ValidateFunc:  computeValidate.LinuxAdminPassword,
+				ConflictsWith: []string{"admin_password"},
+				RequiredWith:  []string{"admin_password_wo_version"},
+			},
+
+			// the Admin Password can only be set during creation, as such changing this recreates the Virtual Machine
+			"admin_password_wo_version": {
+				Type:         pluginsdk.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+				RequiredWith: []string{"admin_password_wo"},
 			},
 
 			"admin_ssh_key": SSHKeysSchema(true),
@@ -760,12 +780,18 @@ func resourceLinuxVirtualMachineCreate(d *pluginsdk.ResourceData, meta interface
 	}
 
 	// "Authentication using either SSH or by user name and password must be enabled in Linux profile." Target="linuxConfiguration"
-	adminPassword := d.Get("admin_password").(string)
+	adminPassword, err := pluginsdk.GetWriteOnlyString(d, "admin_password_wo")
+	if err != nil {
+		return err
+	}
+	if adminPassword == "" {
+		adminPassword = d.Get("admin_password").(string)
+	}
 	if disablePasswordAuthentication && len(sshKeys) == 0 {
 		return fmt.Errorf("at least one `admin_ssh_key` must be specified when `disable_password_authentication` is set to `true`")
 	} else if !disablePasswordAuthentication {
 		if adminPassword == "" {
-			return fmt.Errorf("an `admin_password` must be specified if `disable_password_authentication` is set to `false`")
+			return fmt.Errorf("one of `admin_password` or `admin_password_wo` must be specified if `disable_password_authentication` is set to `false`")
 		}
 
 		params.Properties.OsProfile.AdminPassword = pointer.To(adminPassword)
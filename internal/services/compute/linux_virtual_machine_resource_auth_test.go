@@ -26,6 +26,28 @@ func TestAccLinuxVirtualMachine_authPassword(t *testing.T) {
 	})
 }
 
+func TestAccLinuxVirtualMachine_authPasswordWriteOnly(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_linux_virtual_machine", "test")
+	r := LinuxVirtualMachineResource{}
+
+	data.ResourceTestWriteOnly(t, r, []acceptance.TestStep{
+		{
+			Config: r.authPasswordWriteOnly(data, "P@$$w0rd1234!", 1),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("admin_password_wo_version"),
+		{
+			Config: r.authPasswordWriteOnly(data, "P@$$w0rd5678!", 2),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("admin_password_wo_version"),
+	})
+}
+
 func TestAccLinuxVirtualMachine_authPasswordAndSSH(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_linux_virtual_machine", "test")
 	r := LinuxVirtualMachineResource{}
@@ -140,6 +162,38 @@ resource "azurerm_linux_virtual_machine" "test" {
 `, r.template(data), data.RandomInteger)
 }
 
+func (r LinuxVirtualMachineResource) authPasswordWriteOnly(data acceptance.TestData, password string, version int) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_linux_virtual_machine" "test" {
+  name                            = "acctestVM-%d"
+  resource_group_name             = azurerm_resource_group.test.name
+  location                        = azurerm_resource_group.test.location
+  size                            = "Standard_F2"
+  admin_username                  = "adminuser"
+  admin_password_wo               = "%s"
+  admin_password_wo_version       = %d
+  disable_password_authentication = false
+  network_interface_ids = [
+    azurerm_network_interface.test.id,
+  ]
+
+  os_disk {
+    caching              = "ReadWrite"
+    storage_account_type = "Standard_LRS"
+  }
+
+  source_image_reference {
+    publisher = "Canonical"
+    offer     = "0001-com-ubuntu-server-jammy"
+    sku       = "22_04-lts"
+    version   = "latest"
+  }
+}
+`, r.template(data), data.RandomInteger, password, version)
+}
+
 func (r LinuxVirtualMachineResource) authPasswordAndSSH(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 %s
@@ -10,6 +10,7 @@ import (
 	vaults20230701 "github.com/hashicorp/go-azure-sdk/resource-manager/keyvault/2023-07-01/vaults"
 	resources20151101 "github.com/hashicorp/go-azure-sdk/resource-manager/resources/2015-11-01/resources"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/diskcache"
 	dataplane "github.com/tombuildsstuff/kermit/sdk/keyvault/7.4/keyvault"
 )
 
@@ -35,6 +36,9 @@ type Client struct {
 	// for regular operations, and we can remove this internal client one the newer API version is used
 	// across the Provider.
 	vaults20230701Client *vaults20230701.VaultsClient
+
+	// diskCache is used to persist the Key Vaults within a Subscription between Terraform runs, and is nil unless enabled
+	diskCache *diskcache.Cache
 }
 
 func NewClient(o *common.ClientOptions) (*Client, error) {
@@ -64,5 +68,7 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 		// intentionally internal to this package for now, see above.
 		resources20151101Client: resources20151101Client,
 		vaults20230701Client:    updatedVaultsClient,
+
+		diskCache: o.DiskCache,
 	}, nil
 }
@@ -6,6 +6,7 @@ package client
 import (
 	"context"
 	"fmt"
+	"log"
 	"net/url"
 	"strings"
 	"sync"
@@ -14,6 +15,9 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 )
 
+// keyVaultsDiskCacheName is the name of the item used to store the Key Vaults within a Subscription in the disk cache
+const keyVaultsDiskCacheName = "keyVaults"
+
 var (
 	keyVaultsCache = map[string]keyVaultDetails{}
 	keysmith       = &sync.RWMutex{}
@@ -129,10 +133,16 @@ func (c *Client) KeyVaultIDFromBaseUrl(ctx context.Context, subscriptionId commo
 		return &v.keyVaultId, nil
 	}
 
+	// Check the disk cache (when enabled) to avoid listing all of the Key Vaults within the Subscription
+	if v := c.keyVaultIdFromDiskCache(subscriptionId, cacheKey); v != nil {
+		return v, nil
+	}
+
 	// Populate the cache
 	if err := c.populateCache(ctx, subscriptionId); err != nil {
 		return nil, fmt.Errorf("populating the Key Vaults cache for %s: %+v", subscriptionId, err)
 	}
+	c.writeDiskCache(subscriptionId)
 
 	// Now that the cache has been repopulated, check if we have the key vault or not
 	if v, ok := keyVaultsCache[cacheKey]; ok {
@@ -153,6 +163,51 @@ func (c *Client) Purge(keyVaultId commonids.KeyVaultId) {
 	lock[cacheKey].Lock()
 	delete(keyVaultsCache, cacheKey)
 	lock[cacheKey].Unlock()
+
+	// the disk cache is invalidated rather than updated, so that the next lookup lists the Key Vaults again
+	subscriptionId := commonids.NewSubscriptionID(keyVaultId.SubscriptionId)
+	if err := c.diskCache.Delete(subscriptionId, keyVaultsDiskCacheName); err != nil {
+		log.Printf("[DEBUG] Unable to remove the cached Key Vaults for %s: %+v", subscriptionId, err)
+	}
+}
+
+// keyVaultIdFromDiskCache returns the ID of the Key Vault from the disk cache, or nil when it isn't cached.
+//
+// Entries from the disk cache are intentionally not added to the in-memory cache, since they're only used to avoid
+// listing all of the Key Vaults within the Subscription - other lookups continue to retrieve the Key Vault.
+func (c *Client) keyVaultIdFromDiskCache(subscriptionId commonids.SubscriptionId, cacheKey string) *string {
+	keyVaultIds := make(map[string]string)
+	if !c.diskCache.Get(subscriptionId, keyVaultsDiskCacheName, &keyVaultIds) {
+		return nil
+	}
+
+	if v, ok := keyVaultIds[cacheKey]; ok {
+		return &v
+	}
+
+	return nil
+}
+
+// writeDiskCache writes the IDs of the Key Vaults within the Subscription from the in-memory cache to the disk cache
+func (c *Client) writeDiskCache(subscriptionId commonids.SubscriptionId) {
+	if c.diskCache == nil {
+		return
+	}
+
+	keyVaultIds := make(map[string]string)
+	keysmith.Lock()
+	for cacheKey, v := range keyVaultsCache {
+		id, err := commonids.ParseKeyVaultIDInsensitively(v.keyVaultId)
+		if err != nil || !strings.EqualFold(id.SubscriptionId, subscriptionId.SubscriptionId) {
+			continue
+		}
+		keyVaultIds[cacheKey] = v.keyVaultId
+	}
+	keysmith.Unlock()
+
+	if err := c.diskCache.Set(subscriptionId, keyVaultsDiskCacheName, keyVaultIds); err != nil {
+		log.Printf("[DEBUG] Unable to cache the Key Vaults for %s: %+v", subscriptionId, err)
+	}
 }
 
 func (c *Client) cacheKeyForKeyVault(name string) string {
@@ -0,0 +1,184 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package keyvault
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk/frameworkhelpers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/parse"
+	keyVaultValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+var _ ephemeral.EphemeralResourceWithConfigure = &KeyVaultCertificateEphemeralResource{}
+
+func NewKeyVaultCertificateEphemeralResource() ephemeral.EphemeralResource {
+	return &KeyVaultCertificateEphemeralResource{}
+}
+
+type KeyVaultCertificateEphemeralResource struct {
+	sdk.EphemeralResourceMetadata
+}
+
+type KeyVaultCertificateEphemeralResourceModel struct {
+	Name           types.String `tfsdk:"name"`
+	KeyVaultID     types.String `tfsdk:"key_vault_id"`
+	Version        types.String `tfsdk:"version"`
+	Hex            types.String `tfsdk:"hex"`
+	Pem            types.String `tfsdk:"pem"`
+	Key            types.String `tfsdk:"key"`
+	ExpirationDate types.String `tfsdk:"expiration_date"`
+	NotBeforeDate  types.String `tfsdk:"not_before_date"`
+}
+
+func (e *KeyVaultCertificateEphemeralResource) Metadata(_ context.Context, _ ephemeral.MetadataRequest, response *ephemeral.MetadataResponse) {
+	response.TypeName = "azurerm_key_vault_certificate"
+}
+
+func (e *KeyVaultCertificateEphemeralResource) Configure(_ context.Context, request ephemeral.ConfigureRequest, response *ephemeral.ConfigureResponse) {
+	e.Defaults(request, response)
+}
+
+func (e *KeyVaultCertificateEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					frameworkhelpers.WrappedStringValidator{
+						Func: keyVaultValidate.NestedItemName,
+					},
+				},
+			},
+
+			"key_vault_id": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					frameworkhelpers.WrappedStringValidator{
+						Func: commonids.ValidateKeyVaultID,
+					},
+				},
+			},
+
+			"version": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+			},
+
+			"hex": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"pem": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"key": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"expiration_date": schema.StringAttribute{
+				Computed: true,
+			},
+
+			"not_before_date": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (e *KeyVaultCertificateEphemeralResource) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+	keyVaultsClient := e.Client.KeyVault
+	client := e.Client.KeyVault.ManagementClient
+
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Minute)
+	defer cancel()
+
+	var data KeyVaultCertificateEphemeralResourceModel
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	keyVaultId, err := commonids.ParseKeyVaultID(data.KeyVaultID.ValueString())
+	if err != nil {
+		response.Diagnostics.AddError("Error parsing Key Vault ID", err.Error())
+		return
+	}
+
+	name := data.Name.ValueString()
+	keyVaultBaseUri, err := keyVaultsClient.BaseUriForKeyVault(ctx, *keyVaultId)
+	if err != nil {
+		response.Diagnostics.AddError("Error looking up Key Vault URI", fmt.Sprintf("looking up Certificate %q vault url from id %q: %+v", name, *keyVaultId, err))
+		return
+	}
+
+	cert, err := client.GetCertificate(ctx, *keyVaultBaseUri, name, data.Version.ValueString())
+	if err != nil {
+		if utils.ResponseWasNotFound(cert.Response) {
+			response.Diagnostics.AddError("Key Vault Certificate not found", fmt.Sprintf("the Certificate %q was not found in Key Vault at URI %q", name, *keyVaultBaseUri))
+			return
+		}
+		response.Diagnostics.AddError("Error retrieving Key Vault Certificate", fmt.Sprintf("reading Key Vault Certificate %q: %+v", name, err))
+		return
+	}
+
+	// the version may have changed, so parse the updated id
+	id, err := parse.ParseNestedItemID(pointer.From(cert.ID))
+	if err != nil {
+		response.Diagnostics.AddError("Error parsing Key Vault Certificate ID", err.Error())
+		return
+	}
+
+	data.Version = types.StringValue(id.Version)
+
+	certificateData := ""
+	if contents := cert.Cer; contents != nil {
+		certificateData = strings.ToUpper(hex.EncodeToString(*contents))
+	}
+	data.Hex = types.StringValue(certificateData)
+
+	if attributes := cert.Attributes; attributes != nil {
+		if expires := attributes.Expires; expires != nil {
+			data.ExpirationDate = types.StringValue(time.Time(*expires).Format(time.RFC3339))
+		}
+		if notBefore := attributes.NotBefore; notBefore != nil {
+			data.NotBeforeDate = types.StringValue(time.Time(*notBefore).Format(time.RFC3339))
+		}
+	}
+
+	// the PEM encoded Certificate and Private Key are retrieved from the Secret backing this Certificate
+	secret, err := client.GetSecret(ctx, id.KeyVaultBaseUrl, id.Name, id.Version)
+	if err != nil {
+		response.Diagnostics.AddError("Error retrieving Key Vault Certificate", fmt.Sprintf("retrieving certificate %q from keyvault: %+v", id.Name, err))
+		return
+	}
+
+	certs, key, _, err := flattenKeyVaultCertificateSecretToPEM(id.Name, pointer.From(secret.ContentType), pointer.From(secret.Value))
+	if err != nil {
+		response.Diagnostics.AddError("Error decoding Key Vault Certificate", err.Error())
+		return
+	}
+	data.Pem = types.StringValue(certs)
+	data.Key = types.StringValue(key)
+
+	response.Diagnostics.Append(response.Result.Set(ctx, &data)...)
+}
@@ -0,0 +1,52 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package keyvault_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+)
+
+type KeyVaultCertificateEphemeralResource struct{}
+
+func TestAccEphemeralKeyVaultCertificate_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "ephemeral.azurerm_key_vault_certificate", "test")
+	r := KeyVaultCertificateEphemeralResource{}
+
+	data.EphemeralResourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			ConfigStateChecks: []statecheck.StateCheck{
+				statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("hex"), knownvalue.NotNull()),
+				statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("pem"), knownvalue.NotNull()),
+				statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("key"), knownvalue.NotNull()),
+				statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("not_before_date"), knownvalue.StringExact("2017-10-10T08:27:55Z")),
+				statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("expiration_date"), knownvalue.StringExact("2027-10-08T08:27:55Z")),
+			},
+		},
+	})
+}
+
+func (KeyVaultCertificateEphemeralResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+ephemeral "azurerm_key_vault_certificate" "test" {
+  name         = azurerm_key_vault_certificate.test.name
+  key_vault_id = azurerm_key_vault.test.id
+  version      = azurerm_key_vault_certificate.test.version
+}
+
+provider "echo" {
+  data = ephemeral.azurerm_key_vault_certificate.test
+}
+
+resource "echo" "test" {}
+`, KeyVaultCertificateResource{}.basicImportPFX(data))
+}
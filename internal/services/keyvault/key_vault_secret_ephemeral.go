@@ -0,0 +1,150 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package keyvault
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk/frameworkhelpers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/parse"
+	keyVaultValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+var _ ephemeral.EphemeralResourceWithConfigure = &KeyVaultSecretEphemeralResource{}
+
+func NewKeyVaultSecretEphemeralResource() ephemeral.EphemeralResource {
+	return &KeyVaultSecretEphemeralResource{}
+}
+
+type KeyVaultSecretEphemeralResource struct {
+	sdk.EphemeralResourceMetadata
+}
+
+type KeyVaultSecretEphemeralResourceModel struct {
+	Name           types.String `tfsdk:"name"`
+	KeyVaultID     types.String `tfsdk:"key_vault_id"`
+	Version        types.String `tfsdk:"version"`
+	Value          types.String `tfsdk:"value"`
+	ExpirationDate types.String `tfsdk:"expiration_date"`
+	NotBeforeDate  types.String `tfsdk:"not_before_date"`
+}
+
+func (e *KeyVaultSecretEphemeralResource) Metadata(_ context.Context, _ ephemeral.MetadataRequest, response *ephemeral.MetadataResponse) {
+	response.TypeName = "azurerm_key_vault_secret"
+}
+
+func (e *KeyVaultSecretEphemeralResource) Configure(_ context.Context, request ephemeral.ConfigureRequest, response *ephemeral.ConfigureResponse) {
+	e.Defaults(request, response)
+}
+
+func (e *KeyVaultSecretEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					frameworkhelpers.WrappedStringValidator{
+						Func: keyVaultValidate.NestedItemName,
+					},
+				},
+			},
+
+			"key_vault_id": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					frameworkhelpers.WrappedStringValidator{
+						Func: commonids.ValidateKeyVaultID,
+					},
+				},
+			},
+
+			"version": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+			},
+
+			"value": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"expiration_date": schema.StringAttribute{
+				Computed: true,
+			},
+
+			"not_before_date": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (e *KeyVaultSecretEphemeralResource) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+	keyVaultsClient := e.Client.KeyVault
+	client := e.Client.KeyVault.ManagementClient
+
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Minute)
+	defer cancel()
+
+	var data KeyVaultSecretEphemeralResourceModel
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	keyVaultId, err := commonids.ParseKeyVaultID(data.KeyVaultID.ValueString())
+	if err != nil {
+		response.Diagnostics.AddError("Error parsing Key Vault ID", err.Error())
+		return
+	}
+
+	name := data.Name.ValueString()
+	keyVaultBaseUri, err := keyVaultsClient.BaseUriForKeyVault(ctx, *keyVaultId)
+	if err != nil {
+		response.Diagnostics.AddError("Error looking up Key Vault URI", fmt.Sprintf("looking up Secret %q vault url from id %q: %+v", name, *keyVaultId, err))
+		return
+	}
+
+	resp, err := client.GetSecret(ctx, *keyVaultBaseUri, name, data.Version.ValueString())
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			response.Diagnostics.AddError("Key Vault Secret not found", fmt.Sprintf("KeyVault Secret %q (KeyVault URI %q) does not exist", name, *keyVaultBaseUri))
+			return
+		}
+		response.Diagnostics.AddError("Error retrieving Key Vault Secret", fmt.Sprintf("making Read request on Azure KeyVault Secret %s: %+v", name, err))
+		return
+	}
+
+	// the version may have changed, so parse the updated id
+	id, err := parse.ParseNestedItemID(pointer.From(resp.ID))
+	if err != nil {
+		response.Diagnostics.AddError("Error parsing Key Vault Secret ID", err.Error())
+		return
+	}
+
+	data.Version = types.StringValue(id.Version)
+	data.Value = types.StringPointerValue(resp.Value)
+
+	if attributes := resp.Attributes; attributes != nil {
+		if expires := attributes.Expires; expires != nil {
+			data.ExpirationDate = types.StringValue(time.Time(*expires).Format(time.RFC3339))
+		}
+		if notBefore := attributes.NotBefore; notBefore != nil {
+			data.NotBeforeDate = types.StringValue(time.Time(*notBefore).Format(time.RFC3339))
+		}
+	}
+
+	response.Diagnostics.Append(response.Result.Set(ctx, &data)...)
+}
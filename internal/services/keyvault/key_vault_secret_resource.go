@@ -56,9 +56,28 @@ func resourceKeyVaultSecret() *pluginsdk.Resource {
 			"key_vault_id": commonschema.ResourceIDReferenceRequiredForceNew(&commonids.KeyVaultId{}),
 
 			"value": {
-				Type:      pluginsdk.TypeString,
-				Required:  true,
-				Sensitive: true,
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				ExactlyOneOf: []string{"value", "value_wo"},
+			},
+
+			"value_wo": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				WriteOnly:    true,
+				Sensitive:    true,
+				ExactlyOneOf: []string{"value", "value_wo"},
+				RequiredWith: []string{"value_wo_version"},
+			},
+
+			// the Read function uses the presence of `value_wo_version` to avoid persisting the value of the
+			// Secret into the State, as such this must be a non-zero value
+			"value_wo_version": {
+				Type:         pluginsdk.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+				RequiredWith: []string{"value_wo"},
 			},
 
 			"content_type": {
@@ -134,6 +153,12 @@ func resourceKeyVaultSecretCreate(d *pluginsdk.ResourceData, meta interface{}) e
 	}
 
 	value := d.Get("value").(string)
+	if _, ok := d.GetOk("value_wo_version"); ok {
+		value, err = pluginsdk.GetWriteOnlyString(d, "value_wo")
+		if err != nil {
+			return err
+		}
+	}
 	contentType := d.Get("content_type").(string)
 	t := d.Get("tags").(map[string]interface{})
 
@@ -243,6 +268,14 @@ func resourceKeyVaultSecretUpdate(d *pluginsdk.ResourceData, meta interface{}) e
 	}
 
 	value := d.Get("value").(string)
+	valueChanged := d.HasChange("value")
+	if v, ok := d.GetOk("value_wo_version"); ok && v.(int) > 0 && d.HasChange("value_wo_version") {
+		value, err = pluginsdk.GetWriteOnlyString(d, "value_wo")
+		if err != nil {
+			return err
+		}
+		valueChanged = true
+	}
 	contentType := d.Get("content_type").(string)
 	t := d.Get("tags").(map[string]interface{})
 
@@ -260,7 +293,7 @@ func resourceKeyVaultSecretUpdate(d *pluginsdk.ResourceData, meta interface{}) e
 		secretAttributes.Expires = &expirationUnixTime
 	}
 
-	if d.HasChange("value") {
+	if valueChanged {
 		// for changing the value of the secret we need to create a new version
 		parameters := keyvault.SecretSetParameters{
 			Value:            utils.String(value),
@@ -356,7 +389,10 @@ func resourceKeyVaultSecretRead(d *pluginsdk.ResourceData, meta interface{}) err
 	}
 
 	d.Set("name", respID.Name)
-	d.Set("value", resp.Value)
+	// when the Write-Only `value_wo` is used the value of the Secret mustn't be persisted into the State
+	if _, ok := d.GetOk("value_wo_version"); !ok {
+		d.Set("value", resp.Value)
+	}
 	d.Set("version", respID.Version)
 	d.Set("content_type", resp.ContentType)
 	d.Set("versionless_id", id.VersionlessID())
@@ -0,0 +1,32 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package managedidentity_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/fakearm"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/managedidentity"
+)
+
+func TestUserAssignedIdentityResource_fakeResourceManager(t *testing.T) {
+	server := fakearm.NewServer()
+	defer server.Close()
+
+	server.ResourceTest(t, managedidentity.UserAssignedIdentityResource{}, map[string]interface{}{
+		"name":                "acctestuai",
+		"resource_group_name": "acctestRG",
+		"location":            "westeurope",
+		"tags": map[string]interface{}{
+			"environment": "test",
+		},
+	}, map[string]interface{}{
+		"name":                "acctestuai",
+		"resource_group_name": "acctestRG",
+		"location":            "westeurope",
+		"tags": map[string]interface{}{
+			"environment": "production",
+		},
+	})
+}
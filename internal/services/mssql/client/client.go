@@ -19,6 +19,9 @@ import (
 	"github.com/hashicorp/go-azure-sdk/resource-manager/sql/2023-08-01-preview/geobackuppolicies"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/sql/2023-08-01-preview/jobagents"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/sql/2023-08-01-preview/jobcredentials"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/sql/2023-08-01-preview/jobs"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/sql/2023-08-01-preview/jobsteps"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/sql/2023-08-01-preview/jobtargetgroups"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/sql/2023-08-01-preview/longtermretentionpolicies"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/sql/2023-08-01-preview/outboundfirewallrules"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/sql/2023-08-01-preview/replicationlinks"
@@ -53,6 +56,9 @@ type Client struct {
 	GeoBackupPoliciesClient                            *geobackuppolicies.GeoBackupPoliciesClient
 	JobAgentsClient                                    *jobagents.JobAgentsClient
 	JobCredentialsClient                               *jobcredentials.JobCredentialsClient
+	JobsClient                                         *jobs.JobsClient
+	JobStepsClient                                     *jobsteps.JobStepsClient
+	JobTargetGroupsClient                              *jobtargetgroups.JobTargetGroupsClient
 	LongTermRetentionPoliciesClient                    *longtermretentionpolicies.LongTermRetentionPoliciesClient
 	OutboundFirewallRulesClient                        *outboundfirewallrules.OutboundFirewallRulesClient
 	ReplicationLinksClient                             *replicationlinks.ReplicationLinksClient
@@ -148,6 +154,24 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 	}
 	o.Configure(jobCredentialsClient.Client, o.Authorizers.ResourceManager)
 
+	jobsClient, err := jobs.NewJobsClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building Jobs Client: %+v", err)
+	}
+	o.Configure(jobsClient.Client, o.Authorizers.ResourceManager)
+
+	jobStepsClient, err := jobsteps.NewJobStepsClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building Job Steps Client: %+v", err)
+	}
+	o.Configure(jobStepsClient.Client, o.Authorizers.ResourceManager)
+
+	jobTargetGroupsClient, err := jobtargetgroups.NewJobTargetGroupsClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building Job Target Groups Client: %+v", err)
+	}
+	o.Configure(jobTargetGroupsClient.Client, o.Authorizers.ResourceManager)
+
 	longTermRetentionPoliciesClient, err := longtermretentionpolicies.NewLongTermRetentionPoliciesClientWithBaseURI(o.Environment.ResourceManager)
 	if err != nil {
 		return nil, fmt.Errorf("building Long Term Retention Policies Client: %+v", err)
@@ -272,6 +296,9 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 		FirewallRulesClient:                                firewallRulesClient,
 		JobAgentsClient:                                    jobAgentsClient,
 		JobCredentialsClient:                               jobCredentialsClient,
+		JobsClient:                                         jobsClient,
+		JobStepsClient:                                     jobStepsClient,
+		JobTargetGroupsClient:                              jobTargetGroupsClient,
 		OutboundFirewallRulesClient:                        outboundFirewallRulesClient,
 		ServerDNSAliasClient:                               serverDNSAliasClient,
 		ServerDevOpsAuditSettingsClient:                    serverDevOpsAuditSettingsClient,
@@ -0,0 +1,298 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mssql
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/sql/2023-08-01-preview/jobs"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/suppress"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type MsSqlJobScheduleResource struct{}
+
+type MsSqlJobScheduleResourceModel struct {
+	JobID     string `tfschema:"job_id"`
+	Type      string `tfschema:"type"`
+	Enabled   bool   `tfschema:"enabled"`
+	EndTime   string `tfschema:"end_time"`
+	Interval  string `tfschema:"interval"`
+	StartTime string `tfschema:"start_time"`
+}
+
+var _ sdk.ResourceWithUpdate = MsSqlJobScheduleResource{}
+
+func (MsSqlJobScheduleResource) ModelObject() interface{} {
+	return &MsSqlJobScheduleResourceModel{}
+}
+
+func (MsSqlJobScheduleResource) ResourceType() string {
+	return "azurerm_mssql_job_schedule"
+}
+
+func (MsSqlJobScheduleResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return jobs.ValidateJobID
+}
+
+func (MsSqlJobScheduleResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"job_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: jobs.ValidateJobID,
+		},
+
+		"type": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringInSlice(jobs.PossibleValuesForJobScheduleType(), false),
+		},
+
+		"enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Computed: true,
+		},
+
+		"end_time": {
+			Type:             pluginsdk.TypeString,
+			Optional:         true,
+			Computed:         true,
+			DiffSuppressFunc: suppress.RFC3339Time,
+			ValidateFunc:     validation.IsRFC3339Time,
+		},
+
+		"interval": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+			ValidateFunc: validation.StringMatch(
+				regexp.MustCompile(`^P(\d+Y)?(\d+M)?(\d+W)?(\d+D)?(T(\d+H)?(\d+M)?(\d+S)?)?$`),
+				"`interval` must be an ISO8601 duration, for example `PT15M`",
+			),
+		},
+
+		"start_time": {
+			Type:             pluginsdk.TypeString,
+			Optional:         true,
+			Computed:         true,
+			DiffSuppressFunc: suppress.RFC3339Time,
+			ValidateFunc:     validation.IsRFC3339Time,
+		},
+	}
+}
+
+func (MsSqlJobScheduleResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r MsSqlJobScheduleResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MSSQL.JobsClient
+
+			var model MsSqlJobScheduleResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id, err := jobs.ParseJobID(model.JobID)
+			if err != nil {
+				return err
+			}
+
+			locks.ByID(id.ID())
+			defer locks.UnlockByID(id.ID())
+
+			existing, err := client.Get(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			if existing.Model == nil {
+				return fmt.Errorf("retrieving %s: `model` was nil", *id)
+			}
+			if existing.Model.Properties == nil {
+				return fmt.Errorf("retrieving %s: `model.Properties` was nil", *id)
+			}
+
+			// a Job always has a schedule, which defaults to being run once on demand - so a schedule of any other kind
+			// means that this is already being managed
+			if schedule := existing.Model.Properties.Schedule; schedule != nil {
+				if pointer.From(schedule.Type) == jobs.JobScheduleTypeRecurring || pointer.From(schedule.Enabled) {
+					return metadata.ResourceRequiresImport(r.ResourceType(), id)
+				}
+			}
+
+			parameters := *existing.Model
+			parameters.Properties.Schedule = expandMsSqlJobSchedule(model)
+
+			if _, err := client.CreateOrUpdate(ctx, *id, parameters); err != nil {
+				return fmt.Errorf("creating the Schedule for %s: %+v", *id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (MsSqlJobScheduleResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MSSQL.JobsClient
+
+			id, err := jobs.ParseJobID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := MsSqlJobScheduleResourceModel{
+				JobID: id.ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					if schedule := props.Schedule; schedule != nil {
+						state.Type = string(pointer.From(schedule.Type))
+						state.Enabled = pointer.From(schedule.Enabled)
+						state.EndTime = pointer.From(schedule.EndTime)
+						state.Interval = pointer.From(schedule.Interval)
+						state.StartTime = pointer.From(schedule.StartTime)
+					}
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (MsSqlJobScheduleResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MSSQL.JobsClient
+
+			id, err := jobs.ParseJobID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var config MsSqlJobScheduleResourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			locks.ByID(id.ID())
+			defer locks.UnlockByID(id.ID())
+
+			existing, err := client.Get(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			if existing.Model == nil {
+				return fmt.Errorf("retrieving %s: `model` was nil", *id)
+			}
+			if existing.Model.Properties == nil {
+				return fmt.Errorf("retrieving %s: `model.Properties` was nil", *id)
+			}
+
+			parameters := *existing.Model
+			parameters.Properties.Schedule = expandMsSqlJobSchedule(config)
+
+			if _, err := client.CreateOrUpdate(ctx, *id, parameters); err != nil {
+				return fmt.Errorf("updating the Schedule for %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (MsSqlJobScheduleResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MSSQL.JobsClient
+
+			id, err := jobs.ParseJobID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			locks.ByID(id.ID())
+			defer locks.UnlockByID(id.ID())
+
+			existing, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(existing.HttpResponse) {
+					return nil
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			if existing.Model == nil {
+				return fmt.Errorf("retrieving %s: `model` was nil", *id)
+			}
+			if existing.Model.Properties == nil {
+				return fmt.Errorf("retrieving %s: `model.Properties` was nil", *id)
+			}
+
+			// the schedule can't be removed from a Job, so is reset to the default
+			parameters := *existing.Model
+			parameters.Properties.Schedule = &jobs.JobSchedule{
+				Enabled: pointer.To(false),
+				Type:    pointer.To(jobs.JobScheduleTypeOnce),
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, *id, parameters); err != nil {
+				return fmt.Errorf("deleting the Schedule for %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandMsSqlJobSchedule(input MsSqlJobScheduleResourceModel) *jobs.JobSchedule {
+	output := &jobs.JobSchedule{
+		Enabled: pointer.To(input.Enabled),
+		Type:    pointer.To(jobs.JobScheduleType(input.Type)),
+	}
+
+	if input.EndTime != "" {
+		output.EndTime = pointer.To(input.EndTime)
+	}
+
+	if input.Interval != "" {
+		output.Interval = pointer.To(input.Interval)
+	}
+
+	if input.StartTime != "" {
+		output.StartTime = pointer.To(input.StartTime)
+	}
+
+	return output
+}
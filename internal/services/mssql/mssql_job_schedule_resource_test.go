@@ -0,0 +1,149 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mssql_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/sql/2023-08-01-preview/jobs"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type MsSqlJobScheduleResource struct{}
+
+func TestAccMsSqlJobSchedule_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mssql_job_schedule", "test")
+	r := MsSqlJobScheduleResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccMsSqlJobSchedule_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mssql_job_schedule", "test")
+	r := MsSqlJobScheduleResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccMsSqlJobSchedule_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mssql_job_schedule", "test")
+	r := MsSqlJobScheduleResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (MsSqlJobScheduleResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := jobs.ParseJobID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.MSSQL.JobsClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return nil, fmt.Errorf("%s does not exist", *id)
+		}
+		return nil, fmt.Errorf("reading %s: %v", id, err)
+	}
+
+	return pointer.To(resp.Model != nil && resp.Model.Properties != nil && resp.Model.Properties.Schedule != nil), nil
+}
+
+func (r MsSqlJobScheduleResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_mssql_job_schedule" "test" {
+  job_id   = azurerm_mssql_job.test.id
+  type     = "Recurring"
+  interval = "PT1H"
+}
+`, r.template(data))
+}
+
+func (r MsSqlJobScheduleResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_mssql_job_schedule" "import" {
+  job_id   = azurerm_mssql_job_schedule.test.job_id
+  type     = azurerm_mssql_job_schedule.test.type
+  interval = azurerm_mssql_job_schedule.test.interval
+}
+`, r.basic(data))
+}
+
+func (r MsSqlJobScheduleResource) complete(data acceptance.TestData) string {
+	startTime := time.Now().UTC().AddDate(0, 0, 1).Truncate(24 * time.Hour)
+
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_mssql_job_schedule" "test" {
+  job_id     = azurerm_mssql_job.test.id
+  type       = "Recurring"
+  enabled    = true
+  interval   = "P1D"
+  start_time = "%s"
+  end_time   = "%s"
+}
+`, r.template(data), startTime.Format(time.RFC3339), startTime.AddDate(0, 1, 0).Format(time.RFC3339))
+}
+
+func (MsSqlJobScheduleResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_mssql_job" "test" {
+  name         = "acctestmssqljob%[2]d"
+  job_agent_id = azurerm_mssql_job_agent.test.id
+}
+`, MsSqlJobResource{}.template(data), data.RandomInteger)
+}
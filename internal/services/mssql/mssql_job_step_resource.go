@@ -0,0 +1,479 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mssql
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/sql/2023-08-01-preview/jobcredentials"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/sql/2023-08-01-preview/jobs"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/sql/2023-08-01-preview/jobsteps"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/sql/2023-08-01-preview/jobtargetgroups"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type MsSqlJobStepResource struct{}
+
+type MsSqlJobStepResourceModel struct {
+	Name                           string                     `tfschema:"name"`
+	JobID                          string                     `tfschema:"job_id"`
+	JobStepIndex                   int64                      `tfschema:"job_step_index"`
+	JobTargetGroupID               string                     `tfschema:"job_target_group_id"`
+	SqlScript                      string                     `tfschema:"sql_script"`
+	JobCredentialID                string                     `tfschema:"job_credential_id"`
+	InitialRetryIntervalSeconds    int64                      `tfschema:"initial_retry_interval_seconds"`
+	MaximumRetryIntervalSeconds    int64                      `tfschema:"maximum_retry_interval_seconds"`
+	OutputTarget                   []MsSqlJobStepOutputTarget `tfschema:"output_target"`
+	RetryAttempts                  int64                      `tfschema:"retry_attempts"`
+	RetryIntervalBackoffMultiplier float64                    `tfschema:"retry_interval_backoff_multiplier"`
+	TimeoutSeconds                 int64                      `tfschema:"timeout_seconds"`
+}
+
+type MsSqlJobStepOutputTarget struct {
+	MsSqlDatabaseID string `tfschema:"mssql_database_id"`
+	TableName       string `tfschema:"table_name"`
+	SchemaName      string `tfschema:"schema_name"`
+	JobCredentialID string `tfschema:"job_credential_id"`
+}
+
+var _ sdk.ResourceWithUpdate = MsSqlJobStepResource{}
+
+func (MsSqlJobStepResource) ModelObject() interface{} {
+	return &MsSqlJobStepResourceModel{}
+}
+
+func (MsSqlJobStepResource) ResourceType() string {
+	return "azurerm_mssql_job_step"
+}
+
+func (MsSqlJobStepResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return jobsteps.ValidateStepID
+}
+
+func (MsSqlJobStepResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"job_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: jobs.ValidateJobID,
+		},
+
+		"job_step_index": {
+			Type:         pluginsdk.TypeInt,
+			Required:     true,
+			ValidateFunc: validation.IntAtLeast(1),
+		},
+
+		"job_target_group_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: jobtargetgroups.ValidateTargetGroupID,
+		},
+
+		"sql_script": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"job_credential_id": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: jobcredentials.ValidateCredentialID,
+		},
+
+		"initial_retry_interval_seconds": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			Default:      1,
+			ValidateFunc: validation.IntAtLeast(1),
+		},
+
+		"maximum_retry_interval_seconds": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			Default:      120,
+			ValidateFunc: validation.IntAtLeast(1),
+		},
+
+		"output_target": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"mssql_database_id": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: commonids.ValidateSqlDatabaseID,
+					},
+
+					"table_name": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"job_credential_id": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: jobcredentials.ValidateCredentialID,
+					},
+
+					"schema_name": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						Default:      "dbo",
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+				},
+			},
+		},
+
+		"retry_attempts": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			Default:      10,
+			ValidateFunc: validation.IntAtLeast(0),
+		},
+
+		"retry_interval_backoff_multiplier": {
+			Type:         pluginsdk.TypeFloat,
+			Optional:     true,
+			Default:      2.0,
+			ValidateFunc: validation.FloatAtLeast(1.0),
+		},
+
+		"timeout_seconds": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			Default:      43200,
+			ValidateFunc: validation.IntAtLeast(1),
+		},
+	}
+}
+
+func (MsSqlJobStepResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r MsSqlJobStepResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MSSQL.JobStepsClient
+
+			var model MsSqlJobStepResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			job, err := jobs.ParseJobID(model.JobID)
+			if err != nil {
+				return err
+			}
+
+			id := jobsteps.NewStepID(job.SubscriptionId, job.ResourceGroupName, job.ServerName, job.JobAgentName, job.JobName, model.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil {
+				if !response.WasNotFound(existing.HttpResponse) {
+					return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+				}
+			}
+
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			output, err := expandMsSqlJobStepOutputTarget(model.OutputTarget)
+			if err != nil {
+				return fmt.Errorf("expanding `output_target`: %+v", err)
+			}
+
+			parameters := jobsteps.JobStep{
+				Name: pointer.To(model.Name),
+				Properties: &jobsteps.JobStepProperties{
+					Action: jobsteps.JobStepAction{
+						Source: pointer.To(jobsteps.JobStepActionSourceInline),
+						Type:   pointer.To(jobsteps.JobStepActionTypeTSql),
+						Value:  model.SqlScript,
+					},
+					ExecutionOptions: &jobsteps.JobStepExecutionOptions{
+						InitialRetryIntervalSeconds:    pointer.To(model.InitialRetryIntervalSeconds),
+						MaximumRetryIntervalSeconds:    pointer.To(model.MaximumRetryIntervalSeconds),
+						RetryAttempts:                  pointer.To(model.RetryAttempts),
+						RetryIntervalBackoffMultiplier: pointer.To(model.RetryIntervalBackoffMultiplier),
+						TimeoutSeconds:                 pointer.To(model.TimeoutSeconds),
+					},
+					Output:      output,
+					StepId:      pointer.To(model.JobStepIndex),
+					TargetGroup: model.JobTargetGroupID,
+				},
+			}
+
+			if model.JobCredentialID != "" {
+				parameters.Properties.Credential = pointer.To(model.JobCredentialID)
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, id, parameters); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (MsSqlJobStepResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MSSQL.JobStepsClient
+
+			id, err := jobsteps.ParseStepID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := MsSqlJobStepResourceModel{
+				Name:  id.StepName,
+				JobID: jobs.NewJobID(id.SubscriptionId, id.ResourceGroupName, id.ServerName, id.JobAgentName, id.JobName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					state.JobStepIndex = pointer.From(props.StepId)
+					state.SqlScript = props.Action.Value
+
+					targetGroupId, err := jobtargetgroups.ParseTargetGroupIDInsensitively(props.TargetGroup)
+					if err != nil {
+						return err
+					}
+					state.JobTargetGroupID = targetGroupId.ID()
+
+					if props.Credential != nil {
+						credentialId, err := jobcredentials.ParseCredentialIDInsensitively(*props.Credential)
+						if err != nil {
+							return err
+						}
+						state.JobCredentialID = credentialId.ID()
+					}
+
+					if options := props.ExecutionOptions; options != nil {
+						state.InitialRetryIntervalSeconds = pointer.From(options.InitialRetryIntervalSeconds)
+						state.MaximumRetryIntervalSeconds = pointer.From(options.MaximumRetryIntervalSeconds)
+						state.RetryAttempts = pointer.From(options.RetryAttempts)
+						state.RetryIntervalBackoffMultiplier = pointer.From(options.RetryIntervalBackoffMultiplier)
+						state.TimeoutSeconds = pointer.From(options.TimeoutSeconds)
+					}
+
+					outputTarget, err := flattenMsSqlJobStepOutputTarget(props.Output, *id)
+					if err != nil {
+						return fmt.Errorf("flattening `output_target`: %+v", err)
+					}
+					state.OutputTarget = outputTarget
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (MsSqlJobStepResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MSSQL.JobStepsClient
+
+			id, err := jobsteps.ParseStepID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var config MsSqlJobStepResourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			existing, err := client.Get(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			if existing.Model == nil {
+				return fmt.Errorf("retrieving %s: `model` was nil", *id)
+			}
+			if existing.Model.Properties == nil {
+				return fmt.Errorf("retrieving %s: `model.Properties` was nil", *id)
+			}
+
+			parameters := *existing.Model
+			props := parameters.Properties
+
+			if metadata.ResourceData.HasChange("job_step_index") {
+				props.StepId = pointer.To(config.JobStepIndex)
+			}
+
+			if metadata.ResourceData.HasChange("job_target_group_id") {
+				props.TargetGroup = config.JobTargetGroupID
+			}
+
+			if metadata.ResourceData.HasChange("sql_script") {
+				props.Action.Value = config.SqlScript
+			}
+
+			if metadata.ResourceData.HasChange("job_credential_id") {
+				props.Credential = nil
+				if config.JobCredentialID != "" {
+					props.Credential = pointer.To(config.JobCredentialID)
+				}
+			}
+
+			if metadata.ResourceData.HasChange("output_target") {
+				output, err := expandMsSqlJobStepOutputTarget(config.OutputTarget)
+				if err != nil {
+					return fmt.Errorf("expanding `output_target`: %+v", err)
+				}
+				props.Output = output
+			}
+
+			if props.ExecutionOptions == nil {
+				props.ExecutionOptions = &jobsteps.JobStepExecutionOptions{}
+			}
+
+			if metadata.ResourceData.HasChange("initial_retry_interval_seconds") {
+				props.ExecutionOptions.InitialRetryIntervalSeconds = pointer.To(config.InitialRetryIntervalSeconds)
+			}
+
+			if metadata.ResourceData.HasChange("maximum_retry_interval_seconds") {
+				props.ExecutionOptions.MaximumRetryIntervalSeconds = pointer.To(config.MaximumRetryIntervalSeconds)
+			}
+
+			if metadata.ResourceData.HasChange("retry_attempts") {
+				props.ExecutionOptions.RetryAttempts = pointer.To(config.RetryAttempts)
+			}
+
+			if metadata.ResourceData.HasChange("retry_interval_backoff_multiplier") {
+				props.ExecutionOptions.RetryIntervalBackoffMultiplier = pointer.To(config.RetryIntervalBackoffMultiplier)
+			}
+
+			if metadata.ResourceData.HasChange("timeout_seconds") {
+				props.ExecutionOptions.TimeoutSeconds = pointer.To(config.TimeoutSeconds)
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, *id, parameters); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (MsSqlJobStepResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MSSQL.JobStepsClient
+
+			id, err := jobsteps.ParseStepID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if _, err := client.Delete(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandMsSqlJobStepOutputTarget(input []MsSqlJobStepOutputTarget) (*jobsteps.JobStepOutput, error) {
+	if len(input) == 0 {
+		return nil, nil
+	}
+
+	target := input[0]
+
+	databaseId, err := commonids.ParseSqlDatabaseID(target.MsSqlDatabaseID)
+	if err != nil {
+		return nil, err
+	}
+
+	output := &jobsteps.JobStepOutput{
+		DatabaseName:      databaseId.DatabaseName,
+		ResourceGroupName: pointer.To(databaseId.ResourceGroupName),
+		SchemaName:        pointer.To(target.SchemaName),
+		ServerName:        databaseId.ServerName,
+		SubscriptionId:    pointer.To(databaseId.SubscriptionId),
+		TableName:         target.TableName,
+		Type:              pointer.To(jobsteps.JobStepOutputTypeSqlDatabase),
+	}
+
+	if target.JobCredentialID != "" {
+		output.Credential = pointer.To(target.JobCredentialID)
+	}
+
+	return output, nil
+}
+
+func flattenMsSqlJobStepOutputTarget(input *jobsteps.JobStepOutput, id jobsteps.StepId) ([]MsSqlJobStepOutputTarget, error) {
+	if input == nil {
+		return []MsSqlJobStepOutputTarget{}, nil
+	}
+
+	// the Subscription and Resource Group default to those of the Job Agent when omitted
+	subscriptionId := id.SubscriptionId
+	if v := pointer.From(input.SubscriptionId); v != "" {
+		subscriptionId = v
+	}
+	resourceGroupName := id.ResourceGroupName
+	if v := pointer.From(input.ResourceGroupName); v != "" {
+		resourceGroupName = v
+	}
+
+	output := MsSqlJobStepOutputTarget{
+		MsSqlDatabaseID: commonids.NewSqlDatabaseID(subscriptionId, resourceGroupName, input.ServerName, input.DatabaseName).ID(),
+		SchemaName:      pointer.From(input.SchemaName),
+		TableName:       input.TableName,
+	}
+
+	if input.Credential != nil {
+		credentialId, err := jobcredentials.ParseCredentialIDInsensitively(*input.Credential)
+		if err != nil {
+			return nil, err
+		}
+		output.JobCredentialID = credentialId.ID()
+	}
+
+	return []MsSqlJobStepOutputTarget{output}, nil
+}
@@ -0,0 +1,193 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mssql_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/sql/2023-08-01-preview/jobsteps"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type MsSqlJobStepResource struct{}
+
+func TestAccMsSqlJobStep_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mssql_job_step", "test")
+	r := MsSqlJobStepResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccMsSqlJobStep_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mssql_job_step", "test")
+	r := MsSqlJobStepResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccMsSqlJobStep_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mssql_job_step", "test")
+	r := MsSqlJobStepResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (MsSqlJobStepResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := jobsteps.ParseStepID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.MSSQL.JobStepsClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return nil, fmt.Errorf("%s does not exist", *id)
+		}
+		return nil, fmt.Errorf("reading %s: %v", id, err)
+	}
+
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (r MsSqlJobStepResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_mssql_job_step" "test" {
+  name                = "acctestmssqljobstep%[2]d"
+  job_id              = azurerm_mssql_job.test.id
+  job_step_index      = 1
+  job_target_group_id = azurerm_mssql_job_target_group.test.id
+
+  sql_script = <<EOT
+IF NOT EXISTS (SELECT * FROM sys.objects WHERE [name] = N'Pets')
+  CREATE TABLE Pets (
+    Animal NVARCHAR(50),
+    Name NVARCHAR(50),
+  );
+EOT
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r MsSqlJobStepResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_mssql_job_step" "import" {
+  name                = azurerm_mssql_job_step.test.name
+  job_id              = azurerm_mssql_job_step.test.job_id
+  job_step_index      = azurerm_mssql_job_step.test.job_step_index
+  job_target_group_id = azurerm_mssql_job_step.test.job_target_group_id
+  sql_script          = azurerm_mssql_job_step.test.sql_script
+}
+`, r.basic(data))
+}
+
+func (r MsSqlJobStepResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_mssql_job_credential" "test" {
+  name         = "acctestmssqljobcredential%[2]d"
+  job_agent_id = azurerm_mssql_job_agent.test.id
+  username     = "test"
+  password     = "test"
+}
+
+resource "azurerm_mssql_job_step" "test" {
+  name                = "acctestmssqljobstep%[2]d"
+  job_id              = azurerm_mssql_job.test.id
+  job_step_index      = 1
+  job_target_group_id = azurerm_mssql_job_target_group.test.id
+  job_credential_id   = azurerm_mssql_job_credential.test.id
+
+  sql_script = <<EOT
+IF NOT EXISTS (SELECT * FROM sys.objects WHERE [name] = N'Pets')
+  CREATE TABLE Pets (
+    Animal NVARCHAR(50),
+    Name NVARCHAR(50),
+  );
+EOT
+
+  initial_retry_interval_seconds    = 5
+  maximum_retry_interval_seconds    = 300
+  retry_attempts                    = 5
+  retry_interval_backoff_multiplier = 3
+  timeout_seconds                   = 3600
+
+  output_target {
+    mssql_database_id = azurerm_mssql_database.test.id
+    table_name        = "JobStepOutput"
+    job_credential_id = azurerm_mssql_job_credential.test.id
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (MsSqlJobStepResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_mssql_job" "test" {
+  name         = "acctestmssqljob%[2]d"
+  job_agent_id = azurerm_mssql_job_agent.test.id
+}
+
+resource "azurerm_mssql_job_target_group" "test" {
+  name         = "acctestmssqljobtargetgroup%[2]d"
+  job_agent_id = azurerm_mssql_job_agent.test.id
+
+  job_target {
+    server_name   = azurerm_mssql_server.test.name
+    database_name = azurerm_mssql_database.test.name
+  }
+}
+`, MsSqlJobResource{}.template(data), data.RandomInteger)
+}
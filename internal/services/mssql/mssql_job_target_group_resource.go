@@ -0,0 +1,353 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mssql
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/sql/2023-08-01-preview/jobcredentials"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/sql/2023-08-01-preview/jobtargetgroups"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type MsSqlJobTargetGroupResource struct{}
+
+type MsSqlJobTargetGroupResourceModel struct {
+	Name       string                 `tfschema:"name"`
+	JobAgentID string                 `tfschema:"job_agent_id"`
+	JobTargets []MsSqlJobTargetSchema `tfschema:"job_target"`
+}
+
+type MsSqlJobTargetSchema struct {
+	ServerName      string `tfschema:"server_name"`
+	DatabaseName    string `tfschema:"database_name"`
+	ElasticPoolName string `tfschema:"elastic_pool_name"`
+	JobCredentialID string `tfschema:"job_credential_id"`
+	MembershipType  string `tfschema:"membership_type"`
+	Type            string `tfschema:"type"`
+}
+
+var _ sdk.ResourceWithUpdate = MsSqlJobTargetGroupResource{}
+
+func (MsSqlJobTargetGroupResource) ModelObject() interface{} {
+	return &MsSqlJobTargetGroupResourceModel{}
+}
+
+func (MsSqlJobTargetGroupResource) ResourceType() string {
+	return "azurerm_mssql_job_target_group"
+}
+
+func (MsSqlJobTargetGroupResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return jobtargetgroups.ValidateTargetGroupID
+}
+
+func (MsSqlJobTargetGroupResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"job_agent_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: jobtargetgroups.ValidateJobAgentID,
+		},
+
+		"job_target": {
+			Type:     pluginsdk.TypeSet,
+			Optional: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"server_name": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"database_name": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"elastic_pool_name": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"job_credential_id": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: jobcredentials.ValidateCredentialID,
+					},
+
+					"membership_type": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						Default:      string(jobtargetgroups.JobTargetGroupMembershipTypeInclude),
+						ValidateFunc: validation.StringInSlice(jobtargetgroups.PossibleValuesForJobTargetGroupMembershipType(), false),
+					},
+
+					"type": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (MsSqlJobTargetGroupResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r MsSqlJobTargetGroupResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MSSQL.JobTargetGroupsClient
+
+			var model MsSqlJobTargetGroupResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			jobAgent, err := jobtargetgroups.ParseJobAgentID(model.JobAgentID)
+			if err != nil {
+				return err
+			}
+
+			id := jobtargetgroups.NewTargetGroupID(jobAgent.SubscriptionId, jobAgent.ResourceGroupName, jobAgent.ServerName, jobAgent.JobAgentName, model.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil {
+				if !response.WasNotFound(existing.HttpResponse) {
+					return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+				}
+			}
+
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			members, err := expandMsSqlJobTargets(model.JobTargets)
+			if err != nil {
+				return fmt.Errorf("expanding `job_target`: %+v", err)
+			}
+
+			parameters := jobtargetgroups.JobTargetGroup{
+				Name: pointer.To(model.Name),
+				Properties: &jobtargetgroups.JobTargetGroupProperties{
+					Members: members,
+				},
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, id, parameters); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (MsSqlJobTargetGroupResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MSSQL.JobTargetGroupsClient
+
+			id, err := jobtargetgroups.ParseTargetGroupID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := MsSqlJobTargetGroupResourceModel{
+				Name:       id.TargetGroupName,
+				JobAgentID: jobtargetgroups.NewJobAgentID(id.SubscriptionId, id.ResourceGroupName, id.ServerName, id.JobAgentName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					jobTargets, err := flattenMsSqlJobTargets(props.Members)
+					if err != nil {
+						return fmt.Errorf("flattening `job_target`: %+v", err)
+					}
+					state.JobTargets = jobTargets
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (MsSqlJobTargetGroupResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MSSQL.JobTargetGroupsClient
+
+			id, err := jobtargetgroups.ParseTargetGroupID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var config MsSqlJobTargetGroupResourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			existing, err := client.Get(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			if existing.Model == nil {
+				return fmt.Errorf("retrieving %s: `model` was nil", *id)
+			}
+			if existing.Model.Properties == nil {
+				return fmt.Errorf("retrieving %s: `model.Properties` was nil", *id)
+			}
+
+			parameters := *existing.Model
+
+			if metadata.ResourceData.HasChange("job_target") {
+				members, err := expandMsSqlJobTargets(config.JobTargets)
+				if err != nil {
+					return fmt.Errorf("expanding `job_target`: %+v", err)
+				}
+				parameters.Properties.Members = members
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, *id, parameters); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (MsSqlJobTargetGroupResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MSSQL.JobTargetGroupsClient
+
+			id, err := jobtargetgroups.ParseTargetGroupID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if _, err := client.Delete(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+// msSqlJobTargetType returns the type of the Job Target, which is determined by the fields specified
+func msSqlJobTargetType(input MsSqlJobTargetSchema) jobtargetgroups.JobTargetType {
+	switch {
+	case input.DatabaseName != "":
+		return jobtargetgroups.JobTargetTypeSqlDatabase
+	case input.ElasticPoolName != "":
+		return jobtargetgroups.JobTargetTypeSqlElasticPool
+	default:
+		return jobtargetgroups.JobTargetTypeSqlServer
+	}
+}
+
+func expandMsSqlJobTargets(input []MsSqlJobTargetSchema) ([]jobtargetgroups.JobTarget, error) {
+	output := make([]jobtargetgroups.JobTarget, 0)
+
+	for _, v := range input {
+		if v.DatabaseName != "" && v.ElasticPoolName != "" {
+			return nil, fmt.Errorf("only one of `database_name` or `elastic_pool_name` can be specified for the `job_target` on server %q", v.ServerName)
+		}
+
+		// the credential is used to enumerate the databases within a server or elastic pool, so is only required when
+		// an entire server or elastic pool is included
+		targetType := msSqlJobTargetType(v)
+		refreshesDatabases := targetType != jobtargetgroups.JobTargetTypeSqlDatabase && v.MembershipType == string(jobtargetgroups.JobTargetGroupMembershipTypeInclude)
+		if refreshesDatabases && v.JobCredentialID == "" {
+			return nil, fmt.Errorf("`job_credential_id` must be specified for the `job_target` including the %s on server %q", targetType, v.ServerName)
+		}
+		if !refreshesDatabases && v.JobCredentialID != "" {
+			return nil, fmt.Errorf("`job_credential_id` can only be specified for a `job_target` which includes an entire server or elastic pool")
+		}
+
+		target := jobtargetgroups.JobTarget{
+			MembershipType: pointer.To(jobtargetgroups.JobTargetGroupMembershipType(v.MembershipType)),
+			ServerName:     pointer.To(v.ServerName),
+			Type:           targetType,
+		}
+
+		if v.DatabaseName != "" {
+			target.DatabaseName = pointer.To(v.DatabaseName)
+		}
+
+		if v.ElasticPoolName != "" {
+			target.ElasticPoolName = pointer.To(v.ElasticPoolName)
+		}
+
+		if v.JobCredentialID != "" {
+			target.RefreshCredential = pointer.To(v.JobCredentialID)
+		}
+
+		output = append(output, target)
+	}
+
+	return output, nil
+}
+
+func flattenMsSqlJobTargets(input []jobtargetgroups.JobTarget) ([]MsSqlJobTargetSchema, error) {
+	output := make([]MsSqlJobTargetSchema, 0)
+
+	for _, v := range input {
+		target := MsSqlJobTargetSchema{
+			ServerName:      pointer.From(v.ServerName),
+			DatabaseName:    pointer.From(v.DatabaseName),
+			ElasticPoolName: pointer.From(v.ElasticPoolName),
+			MembershipType:  string(pointer.From(v.MembershipType)),
+			Type:            string(v.Type),
+		}
+
+		if v.RefreshCredential != nil {
+			credentialId, err := jobcredentials.ParseCredentialIDInsensitively(*v.RefreshCredential)
+			if err != nil {
+				return nil, err
+			}
+			target.JobCredentialID = credentialId.ID()
+		}
+
+		output = append(output, target)
+	}
+
+	return output, nil
+}
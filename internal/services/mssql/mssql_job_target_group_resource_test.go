@@ -0,0 +1,151 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mssql_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/sql/2023-08-01-preview/jobtargetgroups"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type MsSqlJobTargetGroupResource struct{}
+
+func TestAccMsSqlJobTargetGroup_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mssql_job_target_group", "test")
+	r := MsSqlJobTargetGroupResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccMsSqlJobTargetGroup_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mssql_job_target_group", "test")
+	r := MsSqlJobTargetGroupResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccMsSqlJobTargetGroup_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mssql_job_target_group", "test")
+	r := MsSqlJobTargetGroupResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (MsSqlJobTargetGroupResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := jobtargetgroups.ParseTargetGroupID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.MSSQL.JobTargetGroupsClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return nil, fmt.Errorf("%s does not exist", *id)
+		}
+		return nil, fmt.Errorf("reading %s: %v", id, err)
+	}
+
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (r MsSqlJobTargetGroupResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_mssql_job_target_group" "test" {
+  name         = "acctestmssqljobtargetgroup%[2]d"
+  job_agent_id = azurerm_mssql_job_agent.test.id
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r MsSqlJobTargetGroupResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_mssql_job_target_group" "import" {
+  name         = azurerm_mssql_job_target_group.test.name
+  job_agent_id = azurerm_mssql_job_target_group.test.job_agent_id
+}
+`, r.basic(data))
+}
+
+func (r MsSqlJobTargetGroupResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_mssql_job_credential" "test" {
+  name         = "acctestmssqljobcredential%[2]d"
+  job_agent_id = azurerm_mssql_job_agent.test.id
+  username     = "test"
+  password     = "test"
+}
+
+resource "azurerm_mssql_job_target_group" "test" {
+  name         = "acctestmssqljobtargetgroup%[2]d"
+  job_agent_id = azurerm_mssql_job_agent.test.id
+
+  job_target {
+    server_name       = azurerm_mssql_server.test.name
+    job_credential_id = azurerm_mssql_job_credential.test.id
+  }
+
+  job_target {
+    server_name     = azurerm_mssql_server.test.name
+    database_name   = azurerm_mssql_database.test.name
+    membership_type = "Exclude"
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (MsSqlJobTargetGroupResource) template(data acceptance.TestData) string {
+	return MsSqlJobResource{}.template(data)
+}
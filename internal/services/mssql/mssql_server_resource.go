@@ -83,15 +83,31 @@ func resourceMsSqlServer() *pluginsdk.Resource {
 				Computed:     true,
 				ForceNew:     true,
 				AtLeastOneOf: []string{"administrator_login", "azuread_administrator.0.azuread_authentication_only"},
-				RequiredWith: []string{"administrator_login", "administrator_login_password"},
 			},
 
 			"administrator_login_password": {
-				Type:         pluginsdk.TypeString,
+				Type:          pluginsdk.TypeString,
+				Optional:      true,
+				Sensitive:     true,
+				AtLeastOneOf:  []string{"administrator_login_password", "administrator_login_password_wo", "azuread_administrator.0.azuread_authentication_only"},
+				ConflictsWith: []string{"administrator_login_password_wo"},
+				RequiredWith:  []string{"administrator_login"},
+			},
+
+			"administrator_login_password_wo": {
+				Type:          pluginsdk.TypeString,
+				Optional:      true,
+				WriteOnly:     true,
+				Sensitive:     true,
+				ConflictsWith: []string{"administrator_login_password"},
+				RequiredWith:  []string{"administrator_login", "administrator_login_password_wo_version"},
+			},
+
+			"administrator_login_password_wo_version": {
+				Type:         pluginsdk.TypeInt,
 				Optional:     true,
-				Sensitive:    true,
-				AtLeastOneOf: []string{"administrator_login_password", "azuread_administrator.0.azuread_authentication_only"},
-				RequiredWith: []string{"administrator_login", "administrator_login_password"},
+				ValidateFunc: validation.IntAtLeast(1),
+				RequiredWith: []string{"administrator_login_password_wo"},
 			},
 
 			"azuread_administrator": {
@@ -200,6 +216,8 @@ func resourceMsSqlServer() *pluginsdk.Resource {
 			pluginsdk.CustomizeDiffShim(msSqlMinimumTLSVersionDiff),
 
 			pluginsdk.CustomizeDiffShim(msSqlPasswordChangeWhenAADAuthOnly),
+
+			pluginsdk.CustomizeDiffShim(msSqlAdministratorLoginPasswordRequired),
 		),
 	}
 }
@@ -241,8 +259,12 @@ func resourceMsSqlServerCreate(d *pluginsdk.ResourceData, meta interface{}) erro
 		props.Properties.AdministratorLogin = utils.String(v.(string))
 	}
 
-	if v := d.Get("administrator_login_password"); v.(string) != "" {
-		props.Properties.AdministratorLoginPassword = utils.String(v.(string))
+	adminPassword, err := msSqlServerAdministratorLoginPassword(d)
+	if err != nil {
+		return err
+	}
+	if adminPassword != "" {
+		props.Properties.AdministratorLoginPassword = utils.String(adminPassword)
 	}
 
 	// NOTE: You must set the admin before setting the values of the admin...
@@ -372,9 +394,14 @@ func resourceMsSqlServerUpdate(d *pluginsdk.ResourceData, meta interface{}) erro
 			payload.Properties.RestrictOutboundNetworkAccess = pointer.To(servers.ServerNetworkAccessFlagEnabled)
 		}
 
-		if d.HasChange("administrator_login_password") {
-			adminPassword := d.Get("administrator_login_password").(string)
-			payload.Properties.AdministratorLoginPassword = pointer.To(adminPassword)
+		if d.HasChanges("administrator_login_password", "administrator_login_password_wo_version") {
+			adminPassword, err := msSqlServerAdministratorLoginPassword(d)
+			if err != nil {
+				return err
+			}
+			if adminPassword != "" {
+				payload.Properties.AdministratorLoginPassword = pointer.To(adminPassword)
+			}
 		}
 
 		if d.HasChange("minimum_tls_version") {
@@ -707,5 +734,43 @@ func msSqlPasswordChangeWhenAADAuthOnly(ctx context.Context, d *pluginsdk.Resour
 	if old.(bool) && d.HasChange("administrator_login_password") {
 		err = fmt.Errorf("`administrator_login_password` cannot be changed once `azuread_administrator.0.azuread_authentication_only = true`")
 	}
+	if old.(bool) && d.HasChange("administrator_login_password_wo_version") {
+		err = fmt.Errorf("`administrator_login_password_wo_version` cannot be changed once `azuread_administrator.0.azuread_authentication_only = true`")
+	}
 	return
 }
+
+// msSqlAdministratorLoginPasswordRequired ensures that a password is configured alongside `administrator_login` - since
+// the password can come from either `administrator_login_password` or the Write-Only `administrator_login_password_wo`
+// this can't be expressed using `RequiredWith`, so is checked against the Raw Configuration at plan time instead
+func msSqlAdministratorLoginPasswordRequired(ctx context.Context, d *pluginsdk.ResourceDiff, _ interface{}) error {
+	config := d.GetRawConfig()
+	if config.IsNull() || !config.IsKnown() {
+		return nil
+	}
+
+	login := config.GetAttr("administrator_login")
+	if login.IsNull() {
+		return nil
+	}
+
+	if config.GetAttr("administrator_login_password").IsNull() && config.GetAttr("administrator_login_password_wo").IsNull() {
+		return fmt.Errorf("one of `administrator_login_password` or `administrator_login_password_wo` must be specified when `administrator_login` is set")
+	}
+
+	return nil
+}
+
+// msSqlServerAdministratorLoginPassword returns the Administrator Login Password from either `administrator_login_password_wo`
+// or `administrator_login_password` - since Write-Only arguments are only available from the Raw Configuration
+func msSqlServerAdministratorLoginPassword(d *pluginsdk.ResourceData) (string, error) {
+	password, err := pluginsdk.GetWriteOnlyString(d, "administrator_login_password_wo")
+	if err != nil {
+		return "", err
+	}
+	if password != "" {
+		return password, nil
+	}
+
+	return d.Get("administrator_login_password").(string), nil
+}
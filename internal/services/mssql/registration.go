@@ -71,6 +71,10 @@ func (r Registration) DataSources() []sdk.DataSource {
 func (r Registration) Resources() []sdk.Resource {
 	return []sdk.Resource{
 		MsSqlFailoverGroupResource{},
+		MsSqlJobResource{},
+		MsSqlJobScheduleResource{},
+		MsSqlJobStepResource{},
+		MsSqlJobTargetGroupResource{},
 		MsSqlVirtualMachineAvailabilityGroupListenerResource{},
 		MsSqlVirtualMachineGroupResource{},
 		ServerDNSAliasResource{},
@@ -79,10 +79,28 @@ func resourcePostgresqlFlexibleServer() *pluginsdk.Resource {
 			},
 
 			"administrator_password": {
-				Type:         pluginsdk.TypeString,
+				Type:          pluginsdk.TypeString,
+				Optional:      true,
+				Sensitive:     true,
+				ValidateFunc:  validation.StringIsNotEmpty,
+				ConflictsWith: []string{"administrator_password_wo"},
+			},
+
+			"administrator_password_wo": {
+				Type:          pluginsdk.TypeString,
+				Optional:      true,
+				WriteOnly:     true,
+				Sensitive:     true,
+				ValidateFunc:  validation.StringIsNotEmpty,
+				ConflictsWith: []string{"administrator_password"},
+				RequiredWith:  []string{"administrator_password_wo_version"},
+			},
+
+			"administrator_password_wo_version": {
+				Type:         pluginsdk.TypeInt,
 				Optional:     true,
-				Sensitive:    true,
-				ValidateFunc: validation.StringIsNotEmpty,
+				ValidateFunc: validation.IntAtLeast(1),
+				RequiredWith: []string{"administrator_password_wo"},
 			},
 
 			"authentication": {
@@ -475,9 +493,14 @@ func resourcePostgresqlFlexibleServerCreate(d *pluginsdk.ResourceData, meta inte
 		}
 	}
 
+	adminPassword, err := flexibleServerAdministratorPassword(d)
+	if err != nil {
+		return err
+	}
+
 	if createMode == "" || servers.CreateMode(createMode) == servers.CreateModeDefault {
 		_, adminLoginSet := d.GetOk("administrator_login")
-		_, adminPwdSet := d.GetOk("administrator_password")
+		adminPwdSet := adminPassword != ""
 
 		pwdEnabled := true // it defaults to true
 		if authRaw, authExist := d.GetOk("authentication"); authExist {
@@ -493,10 +516,10 @@ func resourcePostgresqlFlexibleServerCreate(d *pluginsdk.ResourceData, meta inte
 			}
 
 			if !adminPwdSet {
-				return fmt.Errorf("`administrator_password` is required when `create_mode` is `Default` and `authentication.password_auth_enabled` is set to `true`")
+				return fmt.Errorf("one of `administrator_password` or `administrator_password_wo` is required when `create_mode` is `Default` and `authentication.password_auth_enabled` is set to `true`")
 			}
 		} else if adminLoginSet || adminPwdSet {
-			return fmt.Errorf("`administrator_login` and `administrator_password` cannot be set during creation when `authentication.password_auth_enabled` is set to `false`")
+			return fmt.Errorf("`administrator_login` and `administrator_password` (or `administrator_password_wo`) cannot be set during creation when `authentication.password_auth_enabled` is set to `false`")
 		}
 
 		if _, ok := d.GetOk("sku_name"); !ok {
@@ -554,8 +577,8 @@ func resourcePostgresqlFlexibleServerCreate(d *pluginsdk.ResourceData, meta inte
 		parameters.Properties.AdministratorLogin = utils.String(v.(string))
 	}
 
-	if v, ok := d.GetOk("administrator_password"); ok && v.(string) != "" {
-		parameters.Properties.AdministratorLoginPassword = utils.String(v.(string))
+	if adminPassword != "" {
+		parameters.Properties.AdministratorLoginPassword = utils.String(adminPassword)
 	}
 
 	if createMode != "" {
@@ -749,11 +772,16 @@ func resourcePostgresqlFlexibleServerUpdate(d *pluginsdk.ResourceData, meta inte
 
 	requireUpdateOnLogin := false // it's required to call Create with `createMode` set to `Update` to update login name.
 
+	adminPassword, err := flexibleServerAdministratorPassword(d)
+	if err != nil {
+		return err
+	}
+
 	createMode := d.Get("create_mode").(string)
 	if createMode == "" || servers.CreateMode(createMode) == servers.CreateModeDefault {
 
 		_, adminLoginSet := d.GetOk("administrator_login")
-		_, adminPwdSet := d.GetOk("administrator_password")
+		adminPwdSet := adminPassword != ""
 
 		pwdEnabled := true // it defaults to true
 		if authRaw, authExist := d.GetOk("authentication"); authExist {
@@ -768,7 +796,7 @@ func resourcePostgresqlFlexibleServerUpdate(d *pluginsdk.ResourceData, meta inte
 				return fmt.Errorf("`administrator_login` is required when `authentication.password_auth_enabled` is set to `true`")
 			}
 			if !adminPwdSet {
-				return fmt.Errorf("`administrator_password` is required when `authentication.password_auth_enabled` is set to `true`")
+				return fmt.Errorf("one of `administrator_password` or `administrator_password_wo` is required when `authentication.password_auth_enabled` is set to `true`")
 			}
 		}
 
@@ -843,8 +871,8 @@ func resourcePostgresqlFlexibleServerUpdate(d *pluginsdk.ResourceData, meta inte
 		}
 	}
 
-	if d.HasChange("administrator_password") {
-		parameters.Properties.AdministratorLoginPassword = utils.String(d.Get("administrator_password").(string))
+	if d.HasChanges("administrator_password", "administrator_password_wo_version") && adminPassword != "" {
+		parameters.Properties.AdministratorLoginPassword = utils.String(adminPassword)
 	}
 
 	if d.HasChange("authentication") {
@@ -923,7 +951,7 @@ func resourcePostgresqlFlexibleServerUpdate(d *pluginsdk.ResourceData, meta inte
 				CreateMode:                 &updateMode,
 				AuthConfig:                 expandFlexibleServerAuthConfig(d.Get("authentication").([]interface{})),
 				AdministratorLogin:         utils.String(d.Get("administrator_login").(string)),
-				AdministratorLoginPassword: utils.String(d.Get("administrator_password").(string)),
+				AdministratorLoginPassword: utils.String(adminPassword),
 				Network:                    expandArmServerNetwork(d),
 			},
 		}
@@ -1281,3 +1309,17 @@ func flattenFlexibleServerDataEncryption(de *servers.DataEncryption) ([]interfac
 
 	return []interface{}{item}, nil
 }
+
+// flexibleServerAdministratorPassword returns the Administrator Password from either `administrator_password_wo`
+// or `administrator_password` - since Write-Only arguments are only available from the Raw Configuration
+func flexibleServerAdministratorPassword(d *pluginsdk.ResourceData) (string, error) {
+	password, err := pluginsdk.GetWriteOnlyString(d, "administrator_password_wo")
+	if err != nil {
+		return "", err
+	}
+	if password != "" {
+		return password, nil
+	}
+
+	return d.Get("administrator_password").(string), nil
+}
@@ -20,6 +20,34 @@ import (
 
 type PostgresqlFlexibleServerResource struct{}
 
+func TestAccPostgresqlFlexibleServer_writeOnlyAdministratorPassword(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_postgresql_flexible_server", "test")
+	r := PostgresqlFlexibleServerResource{}
+	data.ResourceTestWriteOnly(t, r, []acceptance.TestStep{
+		{
+			Config: r.writeOnlyAdministratorPassword(data, "QAZwsx123", 1),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("administrator_password_wo_version", "create_mode"),
+		{
+			Config: r.writeOnlyAdministratorPassword(data, "123wsxQAZ", 2),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("administrator_password_wo_version", "create_mode"),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("administrator_password", "create_mode"),
+	})
+}
+
 func TestAccPostgresqlFlexibleServer_basic(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_postgresql_flexible_server", "test")
 	r := PostgresqlFlexibleServerResource{}
@@ -705,6 +733,24 @@ resource "azurerm_postgresql_flexible_server" "test" {
 `, r.template(data), data.RandomInteger, storageMb)
 }
 
+func (r PostgresqlFlexibleServerResource) writeOnlyAdministratorPassword(data acceptance.TestData, secret string, version int) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_postgresql_flexible_server" "test" {
+  name                              = "acctest-fs-%d"
+  resource_group_name               = azurerm_resource_group.test.name
+  location                          = azurerm_resource_group.test.location
+  administrator_login               = "adminTerraform"
+  administrator_password_wo         = "%s"
+  administrator_password_wo_version = %d
+  version                           = "12"
+  sku_name                          = "GP_Standard_D2s_v3"
+  zone                              = "2"
+}
+`, r.template(data), data.RandomInteger, secret, version)
+}
+
 func (r PostgresqlFlexibleServerResource) basic(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 %s
@@ -6,6 +6,7 @@ package pluginsdk
 import (
 	"context"
 	"log"
+	"sync"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -26,7 +27,7 @@ func ImporterValidatingResourceId(validateFunc IDValidationFunc) *schema.Resourc
 // ImporterValidatingResourceIdThen validates the ID provided at import time is valid
 // using the validateFunc then runs the 'thenFunc', allowing the import to be customised.
 func ImporterValidatingResourceIdThen(validateFunc IDValidationFunc, thenFunc ImporterFunc) *schema.ResourceImporter {
-	return &schema.ResourceImporter{
+	importer := &schema.ResourceImporter{
 		StateContext: func(ctx context.Context, d *ResourceData, meta interface{}) ([]*ResourceData, error) {
 			log.Printf("[DEBUG] Importing Resource - parsing %q", d.Id())
 
@@ -44,4 +45,31 @@ func ImporterValidatingResourceIdThen(validateFunc IDValidationFunc, thenFunc Im
 			return thenFunc(ctx, d, meta)
 		},
 	}
+	validatingImporters.Store(importer, validateFunc)
+	return importer
+}
+
+// validatingImporters is a map of the Importers built using ImporterValidatingResourceIdThen to their validateFunc
+var validatingImporters sync.Map
+
+// ValidateResourceIdForImport validates that id is a valid Resource ID for the resource, using the validateFunc
+// of an Importer built using ImporterValidatingResourceId or ImporterValidatingResourceIdThen - without running
+// the 'thenFunc'. This allows tooling to determine which resource(s) a given Resource ID can be imported into.
+//
+// supported is false when the resource doesn't use either of these Importers, in which case id isn't validated.
+func ValidateResourceIdForImport(resource *Resource, id string) (supported bool, err error) {
+	if resource == nil || resource.Importer == nil {
+		return false, nil
+	}
+
+	v, ok := validatingImporters.Load(resource.Importer)
+	if !ok {
+		return false, nil
+	}
+	validateFunc, ok := v.(IDValidationFunc)
+	if !ok || validateFunc == nil {
+		return false, nil
+	}
+
+	return true, validateFunc(id)
 }
@@ -0,0 +1,42 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package pluginsdk
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/go-cty/cty"
+)
+
+// GetWriteOnly returns the value of the Write-Only argument `name` from the Raw Configuration.
+//
+// Write-Only arguments are never persisted into the Plan or State, as such they're unavailable via `d.Get`
+// and must be retrieved from the Raw Configuration - which is only available during Create and Update.
+func GetWriteOnly(d *ResourceData, name string, attributeType cty.Type) (*cty.Value, error) {
+	value, diags := d.GetRawConfigAt(cty.GetAttrPath(name))
+	if diags.HasError() {
+		return nil, fmt.Errorf("retrieving Write-Only argument `%s` from the configuration: %+v", name, diags)
+	}
+
+	if !value.Type().Equals(attributeType) {
+		return nil, fmt.Errorf("retrieving Write-Only argument `%s` from the configuration: expected a %s but got a %s", name, attributeType.FriendlyName(), value.Type().FriendlyName())
+	}
+
+	return &value, nil
+}
+
+// GetWriteOnlyString returns the value of the Write-Only String argument `name` from the Raw Configuration, or
+// an empty string if it's not specified.
+func GetWriteOnlyString(d *ResourceData, name string) (string, error) {
+	value, err := GetWriteOnly(d, name, cty.String)
+	if err != nil {
+		return "", err
+	}
+
+	if value.IsNull() || !value.IsKnown() {
+		return "", nil
+	}
+
+	return value.AsString(), nil
+}
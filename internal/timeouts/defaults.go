@@ -0,0 +1,108 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package timeouts
+
+import (
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+// DefaultTimeouts overrides the default timeouts used by Resources, optionally limited to the specified Resource Types
+type DefaultTimeouts struct {
+	// ResourceTypes is the list of Resource Types these timeouts apply to, each of which can end with a `*` wildcard
+	// (e.g. `azurerm_kubernetes_*`) - when empty these timeouts apply to all Resources
+	ResourceTypes []string
+
+	Create *time.Duration
+	Read   *time.Duration
+	Update *time.Duration
+	Delete *time.Duration
+}
+
+// DefaultTimeoutsForResourceType returns the default timeouts which should be used for the specified Resource Type.
+//
+// Each timeout is taken from the most specific matching override which specifies it - an exact match takes precedence
+// over a wildcard, a longer wildcard takes precedence over a shorter one and a wildcard takes precedence over an
+// override which applies to all Resources. Timeouts which aren't overridden are nil.
+func DefaultTimeoutsForResourceType(overrides []DefaultTimeouts, resourceType string) DefaultTimeouts {
+	output := DefaultTimeouts{}
+	specificity := map[string]int{}
+
+	apply := func(operation string, value *time.Duration, target **time.Duration, score int) {
+		if value == nil {
+			return
+		}
+		if existing, ok := specificity[operation]; ok && existing > score {
+			return
+		}
+		specificity[operation] = score
+		*target = value
+	}
+
+	for _, override := range overrides {
+		score := matchSpecificity(override.ResourceTypes, resourceType)
+		if score < 0 {
+			continue
+		}
+
+		apply(pluginsdk.TimeoutCreate, override.Create, &output.Create, score)
+		apply(pluginsdk.TimeoutRead, override.Read, &output.Read, score)
+		apply(pluginsdk.TimeoutUpdate, override.Update, &output.Update, score)
+		apply(pluginsdk.TimeoutDelete, override.Delete, &output.Delete, score)
+	}
+
+	return output
+}
+
+// ApplyDefaultTimeouts overrides the default timeouts of each Resource with the matching overrides - timeouts are only
+// overridden for operations which the Resource supports, and timeouts specified in a `timeouts` block take precedence
+func ApplyDefaultTimeouts(resources map[string]*pluginsdk.Resource, overrides []DefaultTimeouts) {
+	for resourceType, resource := range resources {
+		if resource.Timeouts == nil {
+			continue
+		}
+
+		defaults := DefaultTimeoutsForResourceType(overrides, resourceType)
+		if resource.Timeouts.Create != nil && defaults.Create != nil {
+			resource.Timeouts.Create = defaults.Create
+		}
+		if resource.Timeouts.Read != nil && defaults.Read != nil {
+			resource.Timeouts.Read = defaults.Read
+		}
+		if resource.Timeouts.Update != nil && defaults.Update != nil {
+			resource.Timeouts.Update = defaults.Update
+		}
+		if resource.Timeouts.Delete != nil && defaults.Delete != nil {
+			resource.Timeouts.Delete = defaults.Delete
+		}
+	}
+}
+
+// matchSpecificity returns how specifically the Resource Types match the Resource Type, or -1 when they don't match
+func matchSpecificity(resourceTypes []string, resourceType string) int {
+	if len(resourceTypes) == 0 {
+		return 0
+	}
+
+	output := -1
+	for _, pattern := range resourceTypes {
+		score := -1
+		if prefix, ok := strings.CutSuffix(pattern, "*"); ok {
+			if strings.HasPrefix(resourceType, prefix) {
+				score = len(prefix) + 1
+			}
+		} else if pattern == resourceType {
+			// an exact match is more specific than any wildcard which matches this Resource Type
+			score = len(pattern) + 2
+		}
+
+		if score > output {
+			output = score
+		}
+	}
+
+	return output
+}
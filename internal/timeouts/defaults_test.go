@@ -0,0 +1,132 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package timeouts
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+func TestDefaultTimeoutsForResourceType(t *testing.T) {
+	overrides := []DefaultTimeouts{
+		{
+			Create: pointer.To(time.Hour),
+			Delete: pointer.To(time.Hour),
+		},
+		{
+			ResourceTypes: []string{"azurerm_kubernetes_*"},
+			Create:        pointer.To(3 * time.Hour),
+		},
+		{
+			ResourceTypes: []string{"azurerm_kubernetes_cluster_*"},
+			Create:        pointer.To(4 * time.Hour),
+		},
+		{
+			ResourceTypes: []string{"azurerm_kubernetes_cluster", "azurerm_linux_*"},
+			Update:        pointer.To(2 * time.Hour),
+		},
+	}
+
+	testData := []struct {
+		resourceType string
+		expected     DefaultTimeouts
+	}{
+		{
+			resourceType: "azurerm_resource_group",
+			expected: DefaultTimeouts{
+				Create: pointer.To(time.Hour),
+				Delete: pointer.To(time.Hour),
+			},
+		},
+		{
+			resourceType: "azurerm_kubernetes_fleet_manager",
+			expected: DefaultTimeouts{
+				Create: pointer.To(3 * time.Hour),
+				Delete: pointer.To(time.Hour),
+			},
+		},
+		{
+			resourceType: "azurerm_kubernetes_cluster",
+			expected: DefaultTimeouts{
+				Create: pointer.To(3 * time.Hour),
+				Update: pointer.To(2 * time.Hour),
+				Delete: pointer.To(time.Hour),
+			},
+		},
+		{
+			resourceType: "azurerm_kubernetes_cluster_node_pool",
+			expected: DefaultTimeouts{
+				Create: pointer.To(4 * time.Hour),
+				Delete: pointer.To(time.Hour),
+			},
+		},
+		{
+			resourceType: "azurerm_linux_virtual_machine",
+			expected: DefaultTimeouts{
+				Create: pointer.To(time.Hour),
+				Update: pointer.To(2 * time.Hour),
+				Delete: pointer.To(time.Hour),
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.resourceType)
+
+		actual := DefaultTimeoutsForResourceType(overrides, v.resourceType)
+		for operation, values := range map[string][]*time.Duration{
+			"create": {v.expected.Create, actual.Create},
+			"read":   {v.expected.Read, actual.Read},
+			"update": {v.expected.Update, actual.Update},
+			"delete": {v.expected.Delete, actual.Delete},
+		} {
+			if pointer.From(values[0]) != pointer.From(values[1]) {
+				t.Fatalf("expected the %s timeout for %q to be %s but got %s", operation, v.resourceType, pointer.From(values[0]), pointer.From(values[1]))
+			}
+		}
+	}
+}
+
+func TestApplyDefaultTimeouts(t *testing.T) {
+	resources := map[string]*pluginsdk.Resource{
+		"azurerm_kubernetes_cluster": {
+			Timeouts: &pluginsdk.ResourceTimeout{
+				Create: pluginsdk.DefaultTimeout(90 * time.Minute),
+				Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			},
+		},
+		"azurerm_resource_group": {
+			Timeouts: &pluginsdk.ResourceTimeout{
+				Read: pluginsdk.DefaultTimeout(5 * time.Minute),
+			},
+		},
+		"azurerm_example": {},
+	}
+
+	ApplyDefaultTimeouts(resources, []DefaultTimeouts{
+		{
+			ResourceTypes: []string{"azurerm_kubernetes_*"},
+			Create:        pointer.To(3 * time.Hour),
+			Update:        pointer.To(3 * time.Hour),
+		},
+	})
+
+	cluster := resources["azurerm_kubernetes_cluster"].Timeouts
+	if *cluster.Create != 3*time.Hour {
+		t.Fatalf("expected the create timeout to be overridden but got %s", *cluster.Create)
+	}
+	if *cluster.Read != 5*time.Minute {
+		t.Fatalf("expected the read timeout to be unchanged but got %s", *cluster.Read)
+	}
+	if cluster.Update != nil {
+		t.Fatalf("expected no update timeout since the resource doesn't support updates but got %s", *cluster.Update)
+	}
+
+	if resourceGroup := resources["azurerm_resource_group"].Timeouts; resourceGroup.Create != nil || *resourceGroup.Read != 5*time.Minute {
+		t.Fatalf("expected the timeouts for a resource which doesn't match to be unchanged")
+	}
+}
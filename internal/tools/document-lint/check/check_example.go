@@ -0,0 +1,225 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package check
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	schema2 "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tools/document-lint/model"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tools/document-lint/schema"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tools/document-lint/util"
+)
+
+type ExampleIssue int
+
+const (
+	ExampleInvalidHCL ExampleIssue = iota
+	ExampleNotInSchema
+	ExampleShouldBeBlock
+	ExampleShouldBeAttribute
+	ExampleComputedOnly
+)
+
+// meta-arguments and blocks which are supported by every resource, so aren't defined in the schema
+var (
+	exampleMetaArguments = map[string]struct{}{"count": {}, "for_each": {}, "provider": {}, "depends_on": {}}
+	exampleMetaBlocks    = map[string]struct{}{"lifecycle": {}, "provisioner": {}, "connection": {}, "timeouts": {}}
+)
+
+type exampleDiff struct {
+	checkBase
+	issue       ExampleIssue
+	msg         string // for invalid HCL only
+	correctName string // for the misspelling of a name not in schema
+}
+
+func newExampleDiff(line int, path string, issue ExampleIssue) *exampleDiff {
+	parts := strings.Split(path, ".")
+	f := &model.Field{
+		Name: parts[len(parts)-1],
+		Path: path,
+		Line: line,
+	}
+	return &exampleDiff{
+		checkBase: newCheckBase(line, path, f),
+		issue:     issue,
+	}
+}
+
+func (e exampleDiff) String() string {
+	switch e.issue {
+	case ExampleInvalidHCL:
+		return fmt.Sprintf("%d the example is not valid HCL: %s", e.Line()+1, util.IssueLine(e.msg))
+	case ExampleNotInSchema:
+		if e.correctName != "" {
+			return fmt.Sprintf("%s in the example does not exist in the schema - should this be %s?", e.checkBase.Str(), util.FixedCode(e.correctName))
+		}
+		return fmt.Sprintf("%s in the example does not exist in the schema", e.checkBase.Str())
+	case ExampleShouldBeBlock:
+		return fmt.Sprintf("%s in the example should be defined as a block", e.checkBase.Str())
+	case ExampleShouldBeAttribute:
+		return fmt.Sprintf("%s in the example should be defined as an attribute, not a block", e.checkBase.Str())
+	case ExampleComputedOnly:
+		return fmt.Sprintf("%s in the example is read-only and cannot be specified", e.checkBase.Str())
+	}
+	return e.checkBase.Str()
+}
+
+// Fix renames a misspelt attribute or block at the start of the line, e.g. `  old_name = ...` or `  dynamic "old_name" {`
+func (e exampleDiff) Fix(line string) (result string, err error) {
+	if e.issue != ExampleNotInSchema || e.correctName == "" {
+		return line, nil
+	}
+
+	name := e.mdField.Name
+	trimmed := strings.TrimLeft(line, " \t")
+	indent := line[:len(line)-len(trimmed)]
+	if dynamic := fmt.Sprintf("dynamic %q", name); strings.HasPrefix(trimmed, dynamic) {
+		return indent + fmt.Sprintf("dynamic %q", e.correctName) + trimmed[len(dynamic):], nil
+	}
+	if strings.HasPrefix(trimmed, name) {
+		if rest := trimmed[len(name):]; rest == "" || strings.ContainsAny(rest[:1], " \t={") {
+			return indent + e.correctName + rest, nil
+		}
+	}
+	return line, nil
+}
+
+var _ Checker = (*exampleDiff)(nil)
+
+// diffExamples parses each HCL example in the document and checks the attributes and blocks used
+// within any `resource` blocks for this resource type exist in the schema with the right nesting
+func diffExamples(r *schema.Resource, md *model.ResourceDoc) (res []Checker) {
+	if r.Schema == nil {
+		return nil
+	}
+
+	for _, example := range md.Examples {
+		file, diags := hclsyntax.ParseConfig([]byte(example.Content), "example.tf", hcl.InitialPos)
+		if diags.HasErrors() {
+			line := example.Line
+			if subject := diags[0].Subject; subject != nil {
+				line += subject.Start.Line - 1
+			}
+			item := newExampleDiff(line, "", ExampleInvalidHCL)
+			item.msg = diags[0].Summary
+			res = append(res, item)
+			continue
+		}
+
+		body, ok := file.Body.(*hclsyntax.Body)
+		if !ok {
+			continue
+		}
+		for _, block := range body.Blocks {
+			if block.Type != "resource" || len(block.Labels) != 2 || block.Labels[0] != r.ResourceType {
+				continue
+			}
+			res = append(res, diffExampleBody(r.ResourceType, example.Line, "", block.Body, r.Schema.Schema)...)
+		}
+	}
+	return res
+}
+
+func diffExampleBody(rt string, offset int, parent string, body *hclsyntax.Body, sch map[string]*schema2.Schema) (res []Checker) {
+	lineOf := func(rng hcl.Range) int {
+		return offset + rng.Start.Line - 1
+	}
+	pathOf := func(name string) string {
+		if parent == "" {
+			return name
+		}
+		return parent + "." + name
+	}
+	newNotInSchema := func(line int, name string) Checker {
+		item := newExampleDiff(line, pathOf(name), ExampleNotInSchema)
+		item.correctName = closestName(name, sch, body)
+		return item
+	}
+
+	for name, attr := range body.Attributes {
+		if _, ok := exampleMetaArguments[name]; ok && parent == "" {
+			continue
+		}
+		if isSkipProp(rt, pathOf(name)) {
+			continue
+		}
+
+		line := lineOf(attr.SrcRange)
+		s, ok := sch[name]
+		switch {
+		case !ok:
+			res = append(res, newNotInSchema(line, name))
+		case !s.Optional && !s.Required:
+			res = append(res, newExampleDiff(line, pathOf(name), ExampleComputedOnly))
+		case isBlockSchema(s) && s.ConfigMode != schema2.SchemaConfigModeAttr:
+			res = append(res, newExampleDiff(line, pathOf(name), ExampleShouldBeBlock))
+		}
+	}
+
+	for _, block := range body.Blocks {
+		name, blockBody := block.Type, block.Body
+		if name == "dynamic" && len(block.Labels) == 1 {
+			name, blockBody = block.Labels[0], nil
+			for _, inner := range block.Body.Blocks {
+				if inner.Type == "content" {
+					blockBody = inner.Body
+				}
+			}
+		}
+		if _, ok := exampleMetaBlocks[name]; ok && parent == "" {
+			continue
+		}
+		if isSkipProp(rt, pathOf(name)) {
+			continue
+		}
+
+		line := lineOf(block.TypeRange)
+		s, ok := sch[name]
+		switch {
+		case !ok:
+			res = append(res, newNotInSchema(line, name))
+		case !isBlockSchema(s):
+			res = append(res, newExampleDiff(line, pathOf(name), ExampleShouldBeAttribute))
+		case !s.Optional && !s.Required:
+			res = append(res, newExampleDiff(line, pathOf(name), ExampleComputedOnly))
+		case blockBody != nil:
+			res = append(res, diffExampleBody(rt, offset, pathOf(name), blockBody, s.Elem.(*schema2.Resource).Schema)...)
+		}
+	}
+
+	sort.SliceStable(res, func(i, j int) bool {
+		return res[i].Line() < res[j].Line()
+	})
+	return res
+}
+
+func isBlockSchema(s *schema2.Schema) bool {
+	_, ok := s.Elem.(*schema2.Resource)
+	return ok && (s.Type == schema2.TypeList || s.Type == schema2.TypeSet)
+}
+
+// closestName returns the name in the schema most similar to name (which isn't already set as an attribute in the body), if any
+func closestName(name string, sch map[string]*schema2.Schema, body *hclsyntax.Body) (res string) {
+	used := map[string]struct{}{}
+	for key := range body.Attributes {
+		used[key] = struct{}{}
+	}
+
+	minDist := 4 // an edit distance of 3 or less is treated as a misspelling, as in mergeMisspelling
+	for key, s := range sch {
+		if _, ok := used[key]; ok || (!s.Optional && !s.Required) {
+			continue
+		}
+		if dist := levenshteinDist(name, key); dist < minDist || (dist == minDist && key < res) {
+			minDist, res = dist, key
+		}
+	}
+	return res
+}
@@ -0,0 +1,120 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package check
+
+import (
+	"testing"
+
+	schema2 "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tools/document-lint/model"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tools/document-lint/schema"
+)
+
+func TestDiffExamples(t *testing.T) {
+	r := &schema.Resource{
+		ResourceType: "azurerm_example",
+		Schema: &schema2.Resource{
+			Schema: map[string]*schema2.Schema{
+				"name":     {Type: schema2.TypeString, Required: true},
+				"location": {Type: schema2.TypeString, Required: true},
+				"tags":     {Type: schema2.TypeMap, Optional: true},
+				"fqdn":     {Type: schema2.TypeString, Computed: true},
+				"network_rules": {
+					Type:     schema2.TypeList,
+					Optional: true,
+					Elem: &schema2.Resource{
+						Schema: map[string]*schema2.Schema{
+							"default_action": {Type: schema2.TypeString, Required: true},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	doc := &model.ResourceDoc{
+		Examples: []model.Example{
+			{
+				Line: 10,
+				Content: `resource "azurerm_resource_group" "example" {
+  name     = "example"
+  location = "West Europe"
+}
+
+resource "azurerm_example" "example" {
+  name      = "example"
+  locaton   = "West Europe"
+  fqdn      = "example.com"
+  count     = 1
+  tags {
+    env = "test"
+  }
+
+  network_rules {
+    default_actions = "Deny"
+  }
+
+  dynamic "network_rule" {
+    for_each = []
+    content {
+      default_action = "Allow"
+    }
+  }
+
+  lifecycle {
+    ignore_changes = [tags]
+  }
+}`,
+			},
+			{
+				Line:    50,
+				Content: "resource \"azurerm_example\" \"example\" {\n  name = \n}",
+			},
+		},
+	}
+
+	expected := []struct {
+		line        int
+		key         string
+		issue       ExampleIssue
+		correctName string
+		fixed       string
+	}{
+		{line: 17, key: "locaton", issue: ExampleNotInSchema, correctName: "location", fixed: "  location   = \"West Europe\""},
+		{line: 18, key: "fqdn", issue: ExampleComputedOnly},
+		{line: 20, key: "tags", issue: ExampleShouldBeAttribute},
+		{line: 25, key: "network_rules.default_actions", issue: ExampleNotInSchema, correctName: "default_action", fixed: "    default_action = \"Deny\""},
+		{line: 28, key: "network_rule", issue: ExampleNotInSchema, correctName: "network_rules", fixed: "  dynamic \"network_rules\" {"},
+		{line: 51, key: "", issue: ExampleInvalidHCL},
+	}
+	lines := map[int]string{
+		17: "  locaton   = \"West Europe\"",
+		25: "    default_actions = \"Deny\"",
+		28: "  dynamic \"network_rule\" {",
+	}
+
+	actual := diffExamples(r, doc)
+	if len(actual) != len(expected) {
+		for _, v := range actual {
+			t.Logf("%s", v.String())
+		}
+		t.Fatalf("expected %d issues but got %d", len(expected), len(actual))
+	}
+
+	for i, v := range expected {
+		item, ok := actual[i].(*exampleDiff)
+		if !ok {
+			t.Fatalf("expected an exampleDiff but got %T", actual[i])
+		}
+		if item.Line() != v.line || item.Key() != v.key || item.issue != v.issue || item.correctName != v.correctName {
+			t.Fatalf("expected %+v but got line %d key %q issue %d correct name %q", v, item.Line(), item.Key(), item.issue, item.correctName)
+		}
+		if v.fixed == "" {
+			continue
+		}
+		if fixed, _ := item.Fix(lines[v.line]); fixed != v.fixed {
+			t.Fatalf("expected %q to be fixed as %q but got %q", lines[v.line], v.fixed, fixed)
+		}
+	}
+}
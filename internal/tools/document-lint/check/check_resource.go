@@ -95,4 +95,7 @@ func (r *ResourceDiff) DiffAll() {
 
 	timeouts := diffTimeout(r.tf, r.md)
 	r.Diff = append(r.Diff, timeouts...)
+
+	examples := diffExamples(r.tf, r.md)
+	r.Diff = append(r.Diff, examples...)
 }
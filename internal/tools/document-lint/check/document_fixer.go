@@ -127,6 +127,12 @@ func (f *Fixer) TryFix() (err error) {
 			return err
 		}
 
+		// lines within an example are HCL, so shouldn't end with a full stop
+		if _, ok := item.(*exampleDiff); ok {
+			lines[lineIdx] = line
+			continue
+		}
+
 		if suf := strings.TrimSuffix(line, " "); suf != "" {
 			if ch := suf[len(suf)-1]; ch != '.' && ch != '?' {
 				line = suf + "."
@@ -365,6 +365,7 @@ func (m *Mark) BuildResourceDoc() *model.ResourceDoc {
 	}
 
 	doc.ResourceName = m.ResourceType
+	doc.Examples = m.examples()
 	for _, item := range m.Items {
 		if item.Type == ItemExample {
 			doc.ExampleHCL = item.content()
@@ -375,3 +376,31 @@ func (m *Mark) BuildResourceDoc() *model.ResourceDoc {
 
 	return doc
 }
+
+// examples returns the HCL code blocks (those fenced with ```hcl or ```terraform) in the document
+func (m *Mark) examples() (res []model.Example) {
+	if m.content == nil {
+		return nil
+	}
+
+	var current *model.Example
+	var lines []string
+	for idx, line := range strings.Split(*m.content, "\n") {
+		trimmed := strings.TrimSpace(line)
+		if current == nil {
+			if lang := strings.TrimPrefix(trimmed, "```"); lang != trimmed && (lang == "hcl" || lang == "terraform") {
+				current = &model.Example{Line: idx + 1}
+				lines = nil
+			}
+			continue
+		}
+		if strings.HasPrefix(trimmed, "```") {
+			current.Content = strings.Join(lines, "\n")
+			res = append(res, *current)
+			current = nil
+			continue
+		}
+		lines = append(lines, line)
+	}
+	return res
+}
@@ -38,3 +38,17 @@ func Test_unmarshalFile(t *testing.T) {
 
 	}
 }
+
+func Test_examples(t *testing.T) {
+	content := "# azurerm_example\n\n## Example Usage\n\n```hcl\nresource \"azurerm_example\" \"example\" {\n  name = \"example\"\n}\n```\n\n## Import\n\n```shell\nterraform import azurerm_example.example /subscriptions/00000000-0000-0000-0000-000000000000\n```\n\n```terraform\nlocals {}\n```\n"
+	examples := newMarkFromString(content, "example.html.markdown").BuildResourceDoc().Examples
+	if len(examples) != 2 {
+		t.Fatalf("expect 2 examples, got: %d", len(examples))
+	}
+	if examples[0].Line != 5 || examples[0].Content != "resource \"azurerm_example\" \"example\" {\n  name = \"example\"\n}" {
+		t.Fatalf("unexpected first example: %+v", examples[0])
+	}
+	if examples[1].Line != 17 || examples[1].Content != "locals {}" {
+		t.Fatalf("unexpected second example: %+v", examples[1])
+	}
+}
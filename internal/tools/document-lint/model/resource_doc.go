@@ -231,11 +231,18 @@ func NewPossibleValue(values []string, f *Field) PossibleValue {
 	}
 }
 
+// Example is a HCL code block within the document
+type Example struct {
+	Line    int // line number of the first line of HCL (the line after the opening ```)
+	Content string
+}
+
 type ResourceDoc struct {
 	ResourceName string
 	Args         Properties
 	Attr         Properties
 	ExampleHCL   string
+	Examples     []Example // all HCL code blocks in the document
 	Timeouts     *Timeouts // nil if no timeouts part in document
 	Import       Import
 
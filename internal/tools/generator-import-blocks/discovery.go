@@ -0,0 +1,124 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/resources/2015-11-01/resources"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/resources/2023-07-01/resourcegroups"
+	"github.com/hashicorp/go-azure-sdk/sdk/auth"
+	"github.com/hashicorp/go-azure-sdk/sdk/environments"
+)
+
+// discoveredResource is a Resource returned from the Resource Manager API
+type discoveredResource struct {
+	// ID is the Resource ID of this Resource
+	ID string
+
+	// Type is the Resource Manager type of this Resource, e.g. `Microsoft.Web/sites`
+	Type string
+}
+
+type discoveryOptions struct {
+	environment    environments.Environment
+	subscriptionId string
+
+	// resourceGroupName optionally limits the discovery to a single Resource Group
+	resourceGroupName string
+}
+
+// discoverResources lists the Resource Groups and top-level Resources within the Subscription (or Resource Group)
+func discoverResources(ctx context.Context, options discoveryOptions, authorizer auth.Authorizer) ([]discoveredResource, error) {
+	subscriptionId := commonids.NewSubscriptionID(options.subscriptionId)
+
+	resourceGroupsClient, err := resourcegroups.NewResourceGroupsClientWithBaseURI(options.environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building Resource Groups client: %+v", err)
+	}
+	resourceGroupsClient.Client.Authorizer = authorizer
+
+	resourcesClient, err := resources.NewResourcesClientWithBaseURI(options.environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building Resources client: %+v", err)
+	}
+	resourcesClient.Client.Authorizer = authorizer
+
+	output := make([]discoveredResource, 0)
+
+	groups, err := resourceGroupsClient.ListComplete(ctx, subscriptionId, resourcegroups.DefaultListOperationOptions())
+	if err != nil {
+		return nil, fmt.Errorf("listing Resource Groups within %s: %+v", subscriptionId, err)
+	}
+	foundResourceGroup := false
+	for _, group := range groups.Items {
+		if group.Id == nil || group.Name == nil {
+			continue
+		}
+		if options.resourceGroupName != "" && !strings.EqualFold(*group.Name, options.resourceGroupName) {
+			continue
+		}
+		foundResourceGroup = true
+		output = append(output, discoveredResource{
+			ID:   *group.Id,
+			Type: "Microsoft.Resources/resourceGroups",
+		})
+	}
+	if options.resourceGroupName != "" && !foundResourceGroup {
+		return nil, fmt.Errorf("the Resource Group %q was not found within %s", options.resourceGroupName, subscriptionId)
+	}
+
+	listOptions := resources.DefaultListOperationOptions()
+	if options.resourceGroupName != "" {
+		filter := fmt.Sprintf("resourceGroup eq '%s'", strings.ReplaceAll(options.resourceGroupName, "'", "''"))
+		listOptions.Filter = &filter
+	}
+	items, err := resourcesClient.ListComplete(ctx, subscriptionId, listOptions)
+	if err != nil {
+		return nil, fmt.Errorf("listing Resources within %s: %+v", subscriptionId, err)
+	}
+	for _, item := range items.Items {
+		if item.Id == nil {
+			continue
+		}
+		resourceType := ""
+		if item.Type != nil {
+			resourceType = *item.Type
+		}
+		output = append(output, discoveredResource{
+			ID:   *item.Id,
+			Type: resourceType,
+		})
+	}
+
+	sort.SliceStable(output, func(i, j int) bool {
+		return strings.ToLower(output[i].ID) < strings.ToLower(output[j].ID)
+	})
+	return output, nil
+}
+
+// buildAuthorizer authenticates using a Service Principal with a Client Secret when the `ARM_CLIENT_ID`,
+// `ARM_CLIENT_SECRET` and `ARM_TENANT_ID` environment variables are set - otherwise using the Azure CLI
+func buildAuthorizer(ctx context.Context, options discoveryOptions) (auth.Authorizer, error) {
+	credentials := auth.Credentials{
+		Environment:                           options.environment,
+		ClientID:                              os.Getenv("ARM_CLIENT_ID"),
+		ClientSecret:                          os.Getenv("ARM_CLIENT_SECRET"),
+		TenantID:                              os.Getenv("ARM_TENANT_ID"),
+		AzureCliSubscriptionIDHint:            options.subscriptionId,
+		EnableAuthenticatingUsingClientSecret: true,
+		EnableAuthenticatingUsingAzureCLI:     true,
+	}
+
+	authorizer, err := auth.NewAuthorizerFromCredentials(ctx, credentials, options.environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building authorizer: %+v", err)
+	}
+	return authorizer, nil
+}
@@ -0,0 +1,75 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"time"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/environments"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/provider"
+)
+
+func main() {
+	f := flag.NewFlagSet("generator-import-blocks", flag.ExitOnError)
+
+	subscriptionId := f.String("subscription-id", os.Getenv("ARM_SUBSCRIPTION_ID"), "the ID of the Subscription to generate import blocks for, defaults to the `ARM_SUBSCRIPTION_ID` environment variable")
+	resourceGroupName := f.String("resource-group", "", "the name of a Resource Group to limit the import blocks to, defaults to the entire Subscription")
+	environmentName := f.String("environment", "public", "the name of the Azure Environment, e.g. `public`, `usgovernment` or `china`")
+	output := f.String("output", "", "the path to write the import blocks to, defaults to stdout")
+
+	if err := f.Parse(os.Args[1:]); err != nil {
+		log.Fatalf("parsing args: %+v", err)
+	}
+	if *subscriptionId == "" {
+		fmt.Fprintln(os.Stderr, "Usage: generator-import-blocks -subscription-id <subscription-id> [-resource-group <name>]")
+		f.PrintDefaults()
+		os.Exit(1)
+	}
+
+	environment, err := environments.FromName(*environmentName)
+	if err != nil {
+		log.Fatalf("determining the environment %q: %+v", *environmentName, err)
+	}
+	options := discoveryOptions{
+		environment:       *environment,
+		subscriptionId:    *subscriptionId,
+		resourceGroupName: *resourceGroupName,
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Minute)
+	defer cancel()
+
+	authorizer, err := buildAuthorizer(ctx, options)
+	if err != nil {
+		log.Fatalf("authenticating: %+v", err)
+	}
+	resources, err := discoverResources(ctx, options, authorizer)
+	if err != nil {
+		log.Fatalf("discovering resources: %+v", err)
+	}
+
+	mapper := mapperFromResources(provider.AzureProvider().ResourcesMap)
+	blocks, unmapped := buildImportBlocks(resources, mapper)
+
+	var w io.Writer = os.Stdout
+	if *output != "" {
+		file, err := os.Create(*output)
+		if err != nil {
+			log.Fatalf("creating %q: %+v", *output, err)
+		}
+		defer file.Close()
+		w = file
+	}
+	if err := writeImportBlocks(w, blocks, unmapped); err != nil {
+		log.Fatalf("writing import blocks: %+v", err)
+	}
+
+	log.Printf("generated %d import blocks, %d resources couldn't be mapped", len(blocks), len(unmapped))
+}
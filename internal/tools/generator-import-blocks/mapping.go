@@ -0,0 +1,164 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+// resourceValidator determines whether a Resource ID can be imported into a given Terraform Resource
+type resourceValidator struct {
+	resourceType string
+	deprecated   bool
+	validate     func(id string) error
+}
+
+// resourceMatch is a Terraform Resource which a Resource ID can be imported into
+type resourceMatch struct {
+	ResourceType string
+	Deprecated   bool
+}
+
+// resourceMapper maps Resource IDs to the Terraform Resource(s) which they can be imported into
+type resourceMapper struct {
+	validators []resourceValidator
+}
+
+// mapperFromResources builds a resourceMapper from the Resources exposed by the Provider, using the
+// validateFunc of the Importer for each Resource - which for Typed Resources is the IDValidationFunc.
+//
+// Resources using a custom Importer are skipped, since these can't be validated without calling the API.
+func mapperFromResources(resources map[string]*pluginsdk.Resource) resourceMapper {
+	validators := make([]resourceValidator, 0)
+	for resourceType, resource := range resources {
+		if supported, _ := pluginsdk.ValidateResourceIdForImport(resource, ""); !supported {
+			continue
+		}
+
+		validators = append(validators, resourceValidator{
+			resourceType: resourceType,
+			deprecated:   resource.DeprecationMessage != "",
+			validate: func(id string) error {
+				_, err := pluginsdk.ValidateResourceIdForImport(resource, id)
+				return err
+			},
+		})
+	}
+
+	sort.Slice(validators, func(i, j int) bool {
+		return validators[i].resourceType < validators[j].resourceType
+	})
+
+	return resourceMapper{
+		validators: validators,
+	}
+}
+
+// match returns the Terraform Resources which the Resource ID can be imported into, ordered by preference.
+//
+// Some Resources accept a generic Resource ID (e.g. any ID within a Resource Group), as such when a more
+// specific match is available these are omitted. Non-deprecated Resources are ordered first, followed by
+// those named after the Resource Manager type (e.g. `azurerm_storage_account` for `Microsoft.Storage/storageAccounts`)
+// since many Resources (e.g. `azurerm_storage_account_network_rules`) manage part of another Resource.
+func (m resourceMapper) match(id, armType string) []resourceMatch {
+	unrelatedId := unrelatedResourceId(id)
+
+	specific := make([]resourceMatch, 0)
+	generic := make([]resourceMatch, 0)
+	for _, v := range m.validators {
+		if err := v.validate(id); err != nil {
+			continue
+		}
+
+		match := resourceMatch{
+			ResourceType: v.resourceType,
+			Deprecated:   v.deprecated,
+		}
+		if unrelatedId != "" && v.validate(unrelatedId) == nil {
+			generic = append(generic, match)
+			continue
+		}
+		specific = append(specific, match)
+	}
+
+	output := specific
+	if len(output) == 0 {
+		output = generic
+	}
+	name := resourceNameForArmType(armType)
+	sort.SliceStable(output, func(i, j int) bool {
+		if output[i].Deprecated != output[j].Deprecated {
+			return !output[i].Deprecated
+		}
+		if x, y := nameScore(output[i].ResourceType, name), nameScore(output[j].ResourceType, name); x != y {
+			return x > y
+		}
+		if len(output[i].ResourceType) != len(output[j].ResourceType) {
+			return len(output[i].ResourceType) < len(output[j].ResourceType)
+		}
+		return output[i].ResourceType < output[j].ResourceType
+	})
+	return output
+}
+
+// resourceNameForArmType returns the singular snake_case name of a Resource Manager type,
+// e.g. `storage_account` for `Microsoft.Storage/storageAccounts`
+func resourceNameForArmType(armType string) string {
+	if armType == "" {
+		return ""
+	}
+	typeName := armType[strings.LastIndex(armType, "/")+1:]
+
+	var sb strings.Builder
+	runes := []rune(typeName)
+	for i, r := range runes {
+		if unicode.IsUpper(r) && i > 0 {
+			// acronyms are kept together, e.g. `publicIPAddresses` becomes `public_ip_addresses`
+			if unicode.IsLower(runes[i-1]) || (i+1 < len(runes) && unicode.IsLower(runes[i+1])) {
+				sb.WriteRune('_')
+			}
+		}
+		sb.WriteRune(unicode.ToLower(r))
+	}
+	name := sb.String()
+
+	switch {
+	case strings.HasSuffix(name, "ies"):
+		return strings.TrimSuffix(name, "ies") + "y"
+	case strings.HasSuffix(name, "sses"):
+		return strings.TrimSuffix(name, "es")
+	}
+	return strings.TrimSuffix(name, "s")
+}
+
+// nameScore returns 2 when the Terraform Resource is named after the Resource Manager type, 1 when it
+// contains the name and otherwise 0
+func nameScore(resourceType, name string) int {
+	switch {
+	case name == "":
+		return 0
+	case resourceType == "azurerm_"+name:
+		return 2
+	case strings.Contains(resourceType, name):
+		return 1
+	}
+	return 0
+}
+
+// unrelatedResourceId returns the Resource ID with the type of the final resource replaced, e.g.
+// `/subscriptions/.../providers/Microsoft.Web/sites/example` becomes `.../providers/Microsoft.Web/unrelatedResources/example`
+// which should only be accepted by Resources using a generic validation function
+func unrelatedResourceId(id string) string {
+	segments := strings.Split(strings.TrimSuffix(id, "/"), "/")
+	if len(segments) < 3 {
+		return ""
+	}
+
+	segments[len(segments)-2] = "unrelatedResources"
+	return strings.Join(segments, "/")
+}
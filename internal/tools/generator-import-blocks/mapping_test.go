@@ -0,0 +1,125 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+func testResourceValidatingSuffix(suffix string) *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			if !strings.Contains(id, suffix) {
+				return fmt.Errorf("parsing %q: expected %q", id, suffix)
+			}
+			return nil
+		}),
+	}
+}
+
+func TestMapperFromResources(t *testing.T) {
+	deprecated := testResourceValidatingSuffix("/providers/Microsoft.Web/sites/")
+	deprecated.DeprecationMessage = "use azurerm_linux_web_app instead"
+
+	mapper := mapperFromResources(map[string]*pluginsdk.Resource{
+		"azurerm_app_service":                   deprecated,
+		"azurerm_linux_web_app":                 testResourceValidatingSuffix("/providers/Microsoft.Web/sites/"),
+		"azurerm_web_app_any":                   testResourceValidatingSuffix("/resourceGroups/"),
+		"azurerm_storage_account":               testResourceValidatingSuffix("/providers/Microsoft.Storage/storageAccounts/"),
+		"azurerm_storage_account_network_rules": testResourceValidatingSuffix("/providers/Microsoft.Storage/storageAccounts/"),
+		"azurerm_account_defender":              testResourceValidatingSuffix("/providers/Microsoft.Storage/storageAccounts/"),
+		"azurerm_custom_import": {
+			Importer: &schema.ResourceImporter{
+				StateContext: func(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) ([]*pluginsdk.ResourceData, error) {
+					panic("custom importers shouldn't be called")
+				},
+			},
+		},
+		"azurerm_no_import": {},
+	})
+
+	testData := []struct {
+		id       string
+		armType  string
+		expected []resourceMatch
+	}{
+		{
+			// the generic match is omitted, and the deprecated resource is ordered last
+			id: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example/providers/Microsoft.Web/sites/app1",
+			expected: []resourceMatch{
+				{ResourceType: "azurerm_linux_web_app"},
+				{ResourceType: "azurerm_app_service", Deprecated: true},
+			},
+		},
+		{
+			// only the generic resource matches
+			id: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example/providers/Microsoft.Sql/servers/server1",
+			expected: []resourceMatch{
+				{ResourceType: "azurerm_web_app_any"},
+			},
+		},
+		{
+			// the resource named after the type is ordered first, then those containing the name
+			id:      "/subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.Storage/storageAccounts/account1",
+			armType: "Microsoft.Storage/storageAccounts",
+			expected: []resourceMatch{
+				{ResourceType: "azurerm_storage_account"},
+				{ResourceType: "azurerm_storage_account_network_rules"},
+				{ResourceType: "azurerm_account_defender"},
+			},
+		},
+		{
+			id:       "/subscriptions/00000000-0000-0000-0000-000000000000",
+			expected: []resourceMatch{},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.id)
+
+		actual := mapper.match(v.id, v.armType)
+		if !reflect.DeepEqual(actual, v.expected) {
+			t.Fatalf("expected %+v but got %+v", v.expected, actual)
+		}
+	}
+}
+
+func TestResourceNameForArmType(t *testing.T) {
+	testData := map[string]string{
+		"Microsoft.Storage/storageAccounts":                                  "storage_account",
+		"Microsoft.Resources/resourceGroups":                                 "resource_group",
+		"Microsoft.Network/dnsZones":                                         "dns_zone",
+		"Microsoft.ApiManagement/service":                                    "service",
+		"Microsoft.Network/applicationGatewayWebApplicationFirewallPolicies": "application_gateway_web_application_firewall_policy",
+		"Microsoft.Network/publicIPAddresses":                                "public_ip_address",
+		"":                                                                   "",
+	}
+
+	for input, expected := range testData {
+		if actual := resourceNameForArmType(input); actual != expected {
+			t.Fatalf("expected %q for %q but got %q", expected, input, actual)
+		}
+	}
+}
+
+func TestUnrelatedResourceId(t *testing.T) {
+	testData := map[string]string{
+		"/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/example":                                 "/subscriptions/11111111-1111-1111-1111-111111111111/unrelatedResources/example",
+		"/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/example/providers/Microsoft.Web/sites/a": "/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/example/providers/Microsoft.Web/unrelatedResources/a",
+		"/subscriptions": "",
+	}
+
+	for input, expected := range testData {
+		if actual := unrelatedResourceId(input); actual != expected {
+			t.Fatalf("expected %q for %q but got %q", expected, input, actual)
+		}
+	}
+}
@@ -0,0 +1,150 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"fmt"
+	"io"
+	"strings"
+)
+
+// importBlock is a Terraform `import` block for a discovered Resource
+type importBlock struct {
+	// ResourceType is the Terraform Resource which the Resource is imported into, e.g. `azurerm_linux_web_app`
+	ResourceType string
+
+	// Label is the name of the Terraform Resource block, e.g. `example`
+	Label string
+
+	// Resource is the discovered Resource being imported
+	Resource discoveredResource
+
+	// Alternatives is a list of other Terraform Resources which can also import this Resource
+	Alternatives []string
+}
+
+// buildImportBlocks maps each of the discovered Resources to an import block, returning the
+// Resources which can't be imported into any Terraform Resource separately
+func buildImportBlocks(input []discoveredResource, mapper resourceMapper) (blocks []importBlock, unmapped []discoveredResource) {
+	blocks = make([]importBlock, 0)
+	unmapped = make([]discoveredResource, 0)
+	labels := map[string]struct{}{}
+
+	for _, resource := range input {
+		matches := mapper.match(resource.ID, resource.Type)
+		if len(matches) == 0 {
+			unmapped = append(unmapped, resource)
+			continue
+		}
+
+		alternatives := make([]string, 0)
+		for _, v := range matches[1:] {
+			alternatives = append(alternatives, v.ResourceType)
+		}
+
+		resourceType := matches[0].ResourceType
+		label := labelFromResourceId(resource.ID)
+		for i := 2; ; i++ {
+			if _, exists := labels[resourceType+"."+label]; !exists {
+				break
+			}
+			label = fmt.Sprintf("%s_%d", labelFromResourceId(resource.ID), i)
+		}
+		labels[resourceType+"."+label] = struct{}{}
+
+		blocks = append(blocks, importBlock{
+			ResourceType: resourceType,
+			Label:        label,
+			Resource:     resource,
+			Alternatives: alternatives,
+		})
+	}
+
+	return blocks, unmapped
+}
+
+// labelFromResourceId returns a valid Terraform block label from the name of the Resource (the last segment of the ID)
+func labelFromResourceId(id string) string {
+	segments := strings.Split(strings.TrimSuffix(id, "/"), "/")
+	name := strings.ToLower(segments[len(segments)-1])
+
+	label := strings.Map(func(r rune) rune {
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') || r == '_' || r == '-' {
+			return r
+		}
+		return '_'
+	}, name)
+
+	if label == "" {
+		return "resource"
+	}
+	if first := label[0]; !(first >= 'a' && first <= 'z') && first != '_' {
+		label = "r_" + label
+	}
+	return label
+}
+
+// writeImportBlocks writes the import blocks (and a list of the unmapped Resources) as HCL
+func writeImportBlocks(w io.Writer, blocks []importBlock, unmapped []discoveredResource) error {
+	var sb strings.Builder
+
+	for i, block := range blocks {
+		if i > 0 {
+			sb.WriteString("\n")
+		}
+		if block.Resource.Type != "" {
+			sb.WriteString(fmt.Sprintf("# %s\n", block.Resource.Type))
+		}
+		if len(block.Alternatives) > 0 {
+			sb.WriteString(fmt.Sprintf("# this can also be imported as: %s\n", strings.Join(block.Alternatives, ", ")))
+		}
+		sb.WriteString("import {\n")
+		sb.WriteString(fmt.Sprintf("  to = %s.%s\n", block.ResourceType, block.Label))
+		sb.WriteString(fmt.Sprintf("  id = %s\n", quoteHCLString(block.Resource.ID)))
+		sb.WriteString("}\n")
+	}
+
+	if len(unmapped) > 0 {
+		if len(blocks) > 0 {
+			sb.WriteString("\n")
+		}
+		sb.WriteString("# The following Resources couldn't be mapped to a Terraform Resource:\n")
+		for _, v := range unmapped {
+			sb.WriteString(fmt.Sprintf("# - %s (%s)\n", v.ID, v.Type))
+		}
+	}
+
+	_, err := io.WriteString(w, sb.String())
+	return err
+}
+
+// quoteHCLString returns input as a quoted HCL string, escaping any characters (or template sequences) as required
+func quoteHCLString(input string) string {
+	var sb strings.Builder
+	sb.WriteString(`"`)
+	for i, r := range input {
+		switch r {
+		case '\\':
+			sb.WriteString(`\\`)
+		case '"':
+			sb.WriteString(`\"`)
+		case '\n':
+			sb.WriteString(`\n`)
+		case '\r':
+			sb.WriteString(`\r`)
+		case '\t':
+			sb.WriteString(`\t`)
+		case '$', '%':
+			// `${` and `%{` begin template sequences, which are escaped by doubling the leading character
+			if i+1 < len(input) && input[i+1] == '{' {
+				sb.WriteRune(r)
+			}
+			sb.WriteRune(r)
+		default:
+			sb.WriteRune(r)
+		}
+	}
+	sb.WriteString(`"`)
+	return sb.String()
+}
@@ -0,0 +1,132 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestLabelFromResourceId(t *testing.T) {
+	testData := map[string]string{
+		"/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/Example-RG": "example-rg",
+		"/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/my.group/":  "my_group",
+		"/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/1group":     "r_1group",
+		"/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/-group":     "r_-group",
+		"/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/_group":     "_group",
+		"": "resource",
+	}
+
+	for input, expected := range testData {
+		if actual := labelFromResourceId(input); actual != expected {
+			t.Fatalf("expected %q for %q but got %q", expected, input, actual)
+		}
+	}
+}
+
+func TestQuoteHCLString(t *testing.T) {
+	testData := map[string]string{
+		`/subscriptions/abc`: `"/subscriptions/abc"`,
+		`a"b\c`:              `"a\"b\\c"`,
+		"tab\there":          `"tab\there"`,
+		"${var}":             `"$${var}"`,
+		"%{if}":              `"%%{if}"`,
+		"100% $5":            `"100% $5"`,
+	}
+
+	for input, expected := range testData {
+		if actual := quoteHCLString(input); actual != expected {
+			t.Fatalf("expected %s for %q but got %s", expected, input, actual)
+		}
+	}
+}
+
+func TestWriteImportBlocks(t *testing.T) {
+	mapper := resourceMapper{
+		validators: []resourceValidator{
+			{
+				resourceType: "azurerm_resource_group",
+				validate: func(id string) error {
+					if strings.Count(id, "/") != 4 {
+						return fmt.Errorf("not a resource group")
+					}
+					return nil
+				},
+			},
+			{
+				resourceType: "azurerm_virtual_network",
+				validate: func(id string) error {
+					if !strings.Contains(id, "/virtualNetworks/") {
+						return fmt.Errorf("not a virtual network")
+					}
+					return nil
+				},
+			},
+			{
+				resourceType: "azurerm_virtual_network_legacy",
+				deprecated:   true,
+				validate: func(id string) error {
+					if !strings.Contains(id, "/virtualNetworks/") {
+						return fmt.Errorf("not a virtual network")
+					}
+					return nil
+				},
+			},
+		},
+	}
+
+	resources := []discoveredResource{
+		{
+			ID:   "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example",
+			Type: "Microsoft.Resources/resourceGroups",
+		},
+		{
+			ID:   "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example/providers/Microsoft.Network/virtualNetworks/example",
+			Type: "Microsoft.Network/virtualNetworks",
+		},
+		{
+			ID:   "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/other/providers/Microsoft.Network/virtualNetworks/Example",
+			Type: "Microsoft.Network/virtualNetworks",
+		},
+		{
+			ID:   "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example/providers/Microsoft.Unknown/things/thing1",
+			Type: "Microsoft.Unknown/things",
+		},
+	}
+
+	blocks, unmapped := buildImportBlocks(resources, mapper)
+
+	var sb strings.Builder
+	if err := writeImportBlocks(&sb, blocks, unmapped); err != nil {
+		t.Fatalf("writing import blocks: %+v", err)
+	}
+
+	expected := `# Microsoft.Resources/resourceGroups
+import {
+  to = azurerm_resource_group.example
+  id = "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example"
+}
+
+# Microsoft.Network/virtualNetworks
+# this can also be imported as: azurerm_virtual_network_legacy
+import {
+  to = azurerm_virtual_network.example
+  id = "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example/providers/Microsoft.Network/virtualNetworks/example"
+}
+
+# Microsoft.Network/virtualNetworks
+# this can also be imported as: azurerm_virtual_network_legacy
+import {
+  to = azurerm_virtual_network.example_2
+  id = "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/other/providers/Microsoft.Network/virtualNetworks/Example"
+}
+
+# The following Resources couldn't be mapped to a Terraform Resource:
+# - /subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example/providers/Microsoft.Unknown/things/thing1 (Microsoft.Unknown/things)
+`
+	if actual := sb.String(); actual != expected {
+		t.Fatalf("expected:\n%s\n\nbut got:\n%s", expected, actual)
+	}
+}
@@ -0,0 +1,502 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"fmt"
+	"go/ast"
+	"sort"
+	"strings"
+)
+
+type propertyKind int
+
+const (
+	kindString propertyKind = iota
+	kindBool
+	kindInt
+	kindFloat
+	kindConstant
+	kindStringList
+	kindStringMap
+	kindObject
+	kindObjectList
+)
+
+// fieldsToSkip are the fields within the top-level model which are either part of the Resource ID or aren't exposed,
+// `ProvisioningState` is skipped at any level
+var fieldsToSkip = map[string]struct{}{
+	"Etag":       {},
+	"Id":         {},
+	"Name":       {},
+	"SystemData": {},
+	"Type":       {},
+}
+
+type property struct {
+	// hclName is the name of this property in the Schema, e.g. `sku_name`
+	hclName string
+
+	// fieldName is the name of this field in both the SDK and Typed Model, e.g. `SkuName`
+	fieldName string
+
+	// sdkPointer specifies whether this field is a pointer within the SDK model
+	sdkPointer bool
+
+	kind propertyKind
+
+	// sdkType is the name of the constant or model within the SDK for kindConstant/kindObject/kindObjectList
+	sdkType string
+	object  *object
+
+	required bool
+	computed bool
+	forceNew bool
+
+	// inProperties specifies whether this (top-level) field is nested within the `Properties` field in the SDK model
+	inProperties bool
+}
+
+type object struct {
+	sdkType    string
+	properties []property
+
+	usedAsObject bool
+	usedAsList   bool
+}
+
+type idSegment struct {
+	// fieldName is the name of the field in the Resource ID struct, e.g. `ResourceGroupName`
+	fieldName string
+
+	// hclName is the name of the property in the Schema, e.g. `resource_group_name`
+	hclName string
+}
+
+type operation struct {
+	method string
+
+	// returnsResult specifies whether this method returns a response alongside an error
+	returnsResult bool
+
+	// options is an expression for the OperationOptions for this method, if required
+	options string
+}
+
+type resourceDefinition struct {
+	resourceType string
+
+	// name is the name of this resource in Camel Case without the `azurerm_` prefix, e.g. `PrivateDnsResolver`
+	name   string
+	client string
+	pkg    *sdkPackage
+
+	modelType  string
+	idType     string
+	idSegments []idSegment
+
+	hasLocation       bool
+	locationPointer   bool
+	hasTags           bool
+	tagsPointer       bool
+	propertiesType    string
+	propertiesPointer bool
+	properties        []property
+
+	// objects is a map of SDK model name to the object generated for it
+	objects map[string]*object
+
+	get    operation
+	create operation
+	update *operation
+	delete operation
+
+	// skipped is a list of properties which the generator was unable to map to the schema
+	skipped []string
+}
+
+func buildResourceDefinition(pkg *sdkPackage, resourceType, modelType, idType, client string, attributes []string) (*resourceDefinition, error) {
+	if !strings.HasPrefix(resourceType, "azurerm_") {
+		return nil, fmt.Errorf("the resource type %q must start with `azurerm_`", resourceType)
+	}
+
+	model := pkg.structType(modelType)
+	if model == nil {
+		return nil, fmt.Errorf("the model %q was not found in %q", modelType, pkg.importPath)
+	}
+
+	if idType == "" {
+		idTypes := pkg.idTypes()
+		if len(idTypes) != 1 {
+			return nil, fmt.Errorf("expected a single Resource ID in %q but found %d (%s), specify the one to use with `-id`", pkg.importPath, len(idTypes), strings.Join(idTypes, ", "))
+		}
+		idType = idTypes[0]
+	}
+	id := pkg.structType(idType)
+	if id == nil {
+		return nil, fmt.Errorf("the Resource ID %q was not found in %q", idType, pkg.importPath)
+	}
+
+	definition := resourceDefinition{
+		resourceType: resourceType,
+		name:         snakeToCamel(strings.TrimPrefix(resourceType, "azurerm_")),
+		client:       client,
+		pkg:          pkg,
+		modelType:    modelType,
+		idType:       idType,
+		objects:      map[string]*object{},
+	}
+
+	for _, field := range id.Fields.List {
+		for _, name := range field.Names {
+			definition.idSegments = append(definition.idSegments, idSegment{
+				fieldName: name.Name,
+				hclName:   convertToSnakeCase(name.Name),
+			})
+		}
+	}
+	if len(definition.idSegments) == 0 {
+		return nil, fmt.Errorf("the Resource ID %q has no segments", idType)
+	}
+	definition.idSegments[len(definition.idSegments)-1].hclName = "name"
+
+	if err := definition.determineOperations(); err != nil {
+		return nil, err
+	}
+
+	computed := make(map[string]struct{})
+	for _, v := range attributes {
+		computed[v] = struct{}{}
+	}
+
+	for _, field := range model.Fields.List {
+		for _, name := range field.Names {
+			switch name.Name {
+			case "Location":
+				definition.hasLocation = true
+				_, definition.locationPointer = field.Type.(*ast.StarExpr)
+				continue
+
+			case "Tags":
+				definition.hasTags = true
+				_, definition.tagsPointer = field.Type.(*ast.StarExpr)
+				continue
+
+			case "Properties":
+				if propertiesType := identName(field.Type); pkg.structType(propertiesType) != nil {
+					definition.propertiesType = propertiesType
+					_, definition.propertiesPointer = field.Type.(*ast.StarExpr)
+					properties := definition.propertiesFor(propertiesType, pkg.structType(propertiesType), map[string]struct{}{modelType: {}})
+					for _, p := range properties {
+						p.inProperties = true
+						definition.properties = append(definition.properties, p)
+					}
+					continue
+				}
+			}
+
+			if p := definition.propertyFor(modelType, name.Name, field.Type, map[string]struct{}{modelType: {}}); p != nil {
+				definition.properties = append(definition.properties, *p)
+			}
+		}
+	}
+
+	for i, p := range definition.properties {
+		if _, ok := computed[p.hclName]; ok {
+			definition.properties[i].computed = true
+			definition.properties[i].required = false
+			delete(computed, p.hclName)
+		}
+		if definition.update == nil {
+			definition.properties[i].forceNew = true
+		}
+	}
+	if len(computed) > 0 {
+		missing := make([]string, 0)
+		for k := range computed {
+			missing = append(missing, k)
+		}
+		sort.Strings(missing)
+		return nil, fmt.Errorf("the attributes %s were not found in the model %q", strings.Join(missing, ", "), modelType)
+	}
+	sort.Slice(definition.properties, func(i, j int) bool {
+		return definition.properties[i].hclName < definition.properties[j].hclName
+	})
+
+	return &definition, nil
+}
+
+func (d *resourceDefinition) propertiesFor(typeName string, model *ast.StructType, parents map[string]struct{}) []property {
+	output := make([]property, 0)
+	for _, field := range model.Fields.List {
+		for _, name := range field.Names {
+			if p := d.propertyFor(typeName, name.Name, field.Type, parents); p != nil {
+				output = append(output, *p)
+			}
+		}
+	}
+	return output
+}
+
+// propertyFor returns the property for the field `fieldName` within the model `typeName`, or nil if
+// this field is skipped or can't be represented in the Schema
+func (d *resourceDefinition) propertyFor(typeName, fieldName string, fieldType ast.Expr, parents map[string]struct{}) *property {
+	if fieldName == "ProvisioningState" {
+		return nil
+	}
+	if _, ok := fieldsToSkip[fieldName]; ok && typeName == d.modelType {
+		return nil
+	}
+
+	p := property{
+		hclName:   convertToSnakeCase(fieldName),
+		fieldName: fieldName,
+	}
+	if v, ok := fieldType.(*ast.StarExpr); ok {
+		p.sdkPointer = true
+		fieldType = v.X
+	}
+	p.required = !p.sdkPointer
+
+	skip := func() *property {
+		d.skipped = append(d.skipped, fmt.Sprintf("%s.%s", typeName, fieldName))
+		return nil
+	}
+
+	switch v := fieldType.(type) {
+	case *ast.Ident:
+		switch v.Name {
+		case "string":
+			p.kind = kindString
+		case "bool":
+			p.kind = kindBool
+		case "int64":
+			p.kind = kindInt
+		case "float64":
+			p.kind = kindFloat
+		default:
+			switch {
+			case d.pkg.isConstant(v.Name):
+				p.kind = kindConstant
+				p.sdkType = v.Name
+			case d.pkg.structType(v.Name) != nil:
+				o := d.objectFor(v.Name, parents)
+				if o == nil {
+					return skip()
+				}
+				o.usedAsObject = true
+				p.kind = kindObject
+				p.sdkType = v.Name
+				p.object = o
+			default:
+				return skip()
+			}
+		}
+
+	case *ast.ArrayType:
+		elem, ok := v.Elt.(*ast.Ident)
+		if !ok || v.Len != nil {
+			return skip()
+		}
+		switch {
+		case elem.Name == "string":
+			p.kind = kindStringList
+		case d.pkg.structType(elem.Name) != nil:
+			o := d.objectFor(elem.Name, parents)
+			if o == nil {
+				return skip()
+			}
+			o.usedAsList = true
+			p.kind = kindObjectList
+			p.sdkType = elem.Name
+			p.object = o
+		default:
+			return skip()
+		}
+
+	case *ast.MapType:
+		key, keyOk := v.Key.(*ast.Ident)
+		value, valueOk := v.Value.(*ast.Ident)
+		if !keyOk || !valueOk || key.Name != "string" || value.Name != "string" {
+			return skip()
+		}
+		p.kind = kindStringMap
+
+	default:
+		// e.g. Identity, Zones or Discriminated Types
+		return skip()
+	}
+
+	return &p
+}
+
+// objectFor returns the object for the SDK model `typeName`, or nil when this model is recursive
+func (d *resourceDefinition) objectFor(typeName string, parents map[string]struct{}) *object {
+	if _, ok := parents[typeName]; ok {
+		return nil
+	}
+	if o, ok := d.objects[typeName]; ok {
+		return o
+	}
+
+	o := &object{
+		sdkType: typeName,
+	}
+	d.objects[typeName] = o
+
+	nestedParents := map[string]struct{}{typeName: {}}
+	for k := range parents {
+		nestedParents[k] = struct{}{}
+	}
+	o.properties = d.propertiesFor(typeName, d.pkg.structType(typeName), nestedParents)
+	sort.Slice(o.properties, func(i, j int) bool {
+		return o.properties[i].hclName < o.properties[j].hclName
+	})
+	return o
+}
+
+func (d *resourceDefinition) determineOperations() error {
+	clientType, err := d.pkg.clientType()
+	if err != nil {
+		return err
+	}
+	methods := d.pkg.methods[clientType]
+
+	find := func(names ...string) *operation {
+		for _, name := range names {
+			method, ok := methods[name]
+			if !ok {
+				continue
+			}
+
+			op := operation{
+				method:        name,
+				returnsResult: method.Type.Results != nil && len(method.Type.Results.List) > 1,
+			}
+			for _, param := range method.Type.Params.List {
+				optionsType := identName(param.Type)
+				if !strings.HasSuffix(optionsType, "OperationOptions") {
+					continue
+				}
+				op.options = fmt.Sprintf("%s.%s{}", d.pkg.name, optionsType)
+				if _, ok := d.pkg.functions["Default"+optionsType]; ok {
+					op.options = fmt.Sprintf("%s.Default%s()", d.pkg.name, optionsType)
+				}
+			}
+			return &op
+		}
+		return nil
+	}
+
+	get := find("Get")
+	if get == nil {
+		return fmt.Errorf("the client %q has no `Get` method", clientType)
+	}
+	d.get = *get
+
+	create := find("CreateOrUpdateThenPoll", "CreateOrUpdate", "CreateThenPoll", "Create")
+	if create == nil {
+		return fmt.Errorf("the client %q has no `Create` or `CreateOrUpdate` method", clientType)
+	}
+	d.create = *create
+
+	if strings.HasPrefix(create.method, "CreateOrUpdate") {
+		d.update = create
+	} else if update := find("UpdateThenPoll", "Update"); update != nil && d.acceptsModel(methods[update.method]) {
+		d.update = update
+	}
+
+	del := find("DeleteThenPoll", "Delete")
+	if del == nil {
+		return fmt.Errorf("the client %q has no `Delete` method", clientType)
+	}
+	d.delete = *del
+
+	return nil
+}
+
+// acceptsModel returns whether the method accepts the SDK model as its payload, rather than
+// a separate Patch model
+func (d *resourceDefinition) acceptsModel(method *ast.FuncDecl) bool {
+	for _, param := range method.Type.Params.List {
+		if identName(param.Type) == d.modelType {
+			return true
+		}
+	}
+	return false
+}
+
+func (d *resourceDefinition) hasSubscriptionId() bool {
+	for _, v := range d.idSegments {
+		if v.fieldName == "SubscriptionId" {
+			return true
+		}
+	}
+	return false
+}
+
+// schemaIdSegments returns the segments of the Resource ID which are exposed in the Schema, the name is
+// returned first followed by any parent segments in the order they appear in the Resource ID
+func (d *resourceDefinition) schemaIdSegments() []idSegment {
+	output := []idSegment{d.idSegments[len(d.idSegments)-1]}
+	for _, v := range d.idSegments[:len(d.idSegments)-1] {
+		if v.fieldName != "SubscriptionId" {
+			output = append(output, v)
+		}
+	}
+	return output
+}
+
+// updatableProperties returns the properties which can be updated in-place
+func (d *resourceDefinition) updatableProperties() []property {
+	output := make([]property, 0)
+	for _, p := range d.properties {
+		if !p.computed && !p.forceNew {
+			output = append(output, p)
+		}
+	}
+	return output
+}
+
+// sortedObjects returns the objects used within this resource, sorted by name
+func (d *resourceDefinition) sortedObjects() []*object {
+	output := make([]*object, 0)
+	for _, v := range d.objects {
+		if v.usedAsObject || v.usedAsList {
+			output = append(output, v)
+		}
+	}
+	sort.Slice(output, func(i, j int) bool {
+		return output[i].sdkType < output[j].sdkType
+	})
+	return output
+}
+
+func snakeToCamel(input string) string {
+	output := ""
+	for _, segment := range strings.Split(input, "_") {
+		if segment == "" {
+			continue
+		}
+		output += strings.ToUpper(segment[:1]) + segment[1:]
+	}
+	return output
+}
+
+func convertToSnakeCase(input string) string {
+	output := make([]rune, 0)
+	runes := []rune(input)
+	for i, r := range runes {
+		lower := strings.ToLower(string(r))
+		if i > 0 && lower != string(r) {
+			previousIsLower := strings.ToLower(string(runes[i-1])) == string(runes[i-1])
+			nextIsLower := i+1 < len(runes) && strings.ToLower(string(runes[i+1])) == string(runes[i+1])
+			if previousIsLower || nextIsLower {
+				output = append(output, '_')
+			}
+		}
+		output = append(output, []rune(lower)...)
+	}
+	return string(output)
+}
@@ -0,0 +1,564 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"path"
+	"sort"
+	"strings"
+)
+
+const (
+	importCommonSchema = "github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	importLocation     = "github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	importPluginSdk    = "github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	importPointer      = "github.com/hashicorp/go-azure-helpers/lang/pointer"
+	importResponse     = "github.com/hashicorp/go-azure-helpers/lang/response"
+	importSdk          = "github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	importValidation   = "github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+// generator writes the source code for a Typed Resource, tracking the imports which are used
+type generator struct {
+	d       *resourceDefinition
+	buf     bytes.Buffer
+	imports map[string]struct{}
+}
+
+func generate(d *resourceDefinition, packageName string) ([]byte, error) {
+	g := generator{
+		d:       d,
+		imports: map[string]struct{}{},
+	}
+
+	g.writeModels()
+	g.writeResource()
+	g.writeSchema()
+	g.writeCreate()
+	g.writeRead()
+	g.writeUpdate()
+	g.writeDelete()
+	g.writeExpandAndFlatten()
+
+	var output bytes.Buffer
+	output.WriteString("// Copyright (c) HashiCorp, Inc.\n// SPDX-License-Identifier: MPL-2.0\n\n")
+	fmt.Fprintf(&output, "package %s\n\n", packageName)
+	output.WriteString(g.importBlock())
+	if len(d.skipped) > 0 {
+		output.WriteString("// TODO: the following fields in the SDK couldn't be mapped to the Schema and need to be added manually:\n")
+		for _, v := range d.skipped {
+			fmt.Fprintf(&output, "// - %s\n", v)
+		}
+		output.WriteString("\n")
+	}
+	output.Write(g.buf.Bytes())
+
+	formatted, err := format.Source(output.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("formatting generated code: %+v\n\n%s", err, output.String())
+	}
+	return formatted, nil
+}
+
+func (g *generator) printf(format string, args ...interface{}) {
+	fmt.Fprintf(&g.buf, format, args...)
+}
+
+// use records that the package at importPath is used, returning the name to reference it by
+func (g *generator) use(importPath string) string {
+	g.imports[importPath] = struct{}{}
+	if importPath == g.d.pkg.importPath {
+		return g.d.pkg.name
+	}
+	return path.Base(importPath)
+}
+
+func (g *generator) sdk() string {
+	return g.use(g.d.pkg.importPath)
+}
+
+func (g *generator) importBlock() string {
+	standard := make([]string, 0)
+	others := make([]string, 0)
+	for k := range g.imports {
+		if strings.Contains(k, ".") {
+			others = append(others, k)
+		} else {
+			standard = append(standard, k)
+		}
+	}
+	sort.Strings(standard)
+	sort.Strings(others)
+
+	lines := make([]string, 0)
+	for _, v := range standard {
+		lines = append(lines, fmt.Sprintf("\t%q", v))
+	}
+	if len(standard) > 0 && len(others) > 0 {
+		lines = append(lines, "")
+	}
+	for _, v := range others {
+		lines = append(lines, fmt.Sprintf("\t%q", v))
+	}
+	return fmt.Sprintf("import (\n%s\n)\n\n", strings.Join(lines, "\n"))
+}
+
+func (g *generator) resourceName() string {
+	return fmt.Sprintf("%sResource", g.d.name)
+}
+
+func (g *generator) modelName() string {
+	return fmt.Sprintf("%sModel", g.d.name)
+}
+
+func (g *generator) objectModelName(o *object) string {
+	return fmt.Sprintf("%s%sModel", g.d.name, o.sdkType)
+}
+
+func (g *generator) writeModels() {
+	g.printf("type %s struct {\n", g.modelName())
+	for _, v := range g.d.schemaIdSegments() {
+		g.printf("%s string `tfschema:%q`\n", snakeToCamel(v.hclName), v.hclName)
+	}
+	if g.d.hasLocation {
+		g.printf("Location string `tfschema:\"location\"`\n")
+	}
+	for _, p := range g.d.properties {
+		g.printf("%s %s `tfschema:%q`\n", p.fieldName, g.modelFieldType(p), p.hclName)
+	}
+	if g.d.hasTags {
+		g.printf("Tags map[string]string `tfschema:\"tags\"`\n")
+	}
+	g.printf("}\n\n")
+
+	for _, o := range g.d.sortedObjects() {
+		g.printf("type %s struct {\n", g.objectModelName(o))
+		for _, p := range o.properties {
+			g.printf("%s %s `tfschema:%q`\n", p.fieldName, g.modelFieldType(p), p.hclName)
+		}
+		g.printf("}\n\n")
+	}
+}
+
+func (g *generator) modelFieldType(p property) string {
+	switch p.kind {
+	case kindBool:
+		return "bool"
+	case kindInt:
+		return "int64"
+	case kindFloat:
+		return "float64"
+	case kindStringList:
+		return "[]string"
+	case kindStringMap:
+		return "map[string]string"
+	case kindObject, kindObjectList:
+		return fmt.Sprintf("[]%s", g.objectModelName(p.object))
+	}
+	return "string"
+}
+
+func (g *generator) writeResource() {
+	resourceInterface := "Resource"
+	if g.hasUpdate() {
+		resourceInterface = "ResourceWithUpdate"
+	}
+
+	g.printf("type %s struct{}\n\n", g.resourceName())
+	g.printf("var _ %s.%s = %s{}\n\n", g.use(importSdk), resourceInterface, g.resourceName())
+
+	g.printf("func (r %s) ResourceType() string {\nreturn %q\n}\n\n", g.resourceName(), g.d.resourceType)
+	g.printf("func (r %s) ModelObject() interface{} {\nreturn &%s{}\n}\n\n", g.resourceName(), g.modelName())
+	g.printf("func (r %s) IDValidationFunc() %s.SchemaValidateFunc {\nreturn %s.Validate%sID\n}\n\n", g.resourceName(), g.use(importPluginSdk), g.sdk(), strings.TrimSuffix(g.d.idType, "Id"))
+}
+
+func (g *generator) hasUpdate() bool {
+	return g.d.update != nil && (len(g.d.updatableProperties()) > 0 || g.d.hasTags)
+}
+
+func (g *generator) writeSchema() {
+	pluginsdk := g.use(importPluginSdk)
+
+	g.printf("func (r %s) Arguments() map[string]*%s.Schema {\nreturn map[string]*%s.Schema{\n", g.resourceName(), pluginsdk, pluginsdk)
+	for _, v := range g.d.schemaIdSegments() {
+		switch v.fieldName {
+		case "ResourceGroupName":
+			g.printf("%q: %s.ResourceGroupName(),\n\n", v.hclName, g.use(importCommonSchema))
+		default:
+			g.printf("%q: {\nType: %s.TypeString,\nRequired: true,\nForceNew: true,\nValidateFunc: %s.StringIsNotEmpty,\n},\n\n", v.hclName, pluginsdk, g.use(importValidation))
+		}
+	}
+	if g.d.hasLocation {
+		g.printf("\"location\": %s.Location(),\n\n", g.use(importCommonSchema))
+	}
+	for _, p := range g.d.properties {
+		if !p.computed {
+			g.printf("%q: %s,\n\n", p.hclName, g.schemaFor(p))
+		}
+	}
+	if g.d.hasTags {
+		g.printf("\"tags\": %s.Tags(),\n", g.use(importCommonSchema))
+	}
+	g.printf("}\n}\n\n")
+
+	g.printf("func (r %s) Attributes() map[string]*%s.Schema {\nreturn map[string]*%s.Schema{\n", g.resourceName(), pluginsdk, pluginsdk)
+	for _, p := range g.d.properties {
+		if p.computed {
+			g.printf("%q: %s,\n\n", p.hclName, g.schemaFor(p))
+		}
+	}
+	g.printf("}\n}\n\n")
+}
+
+// schemaFor returns the Schema definition for the property p
+func (g *generator) schemaFor(p property) string {
+	pluginsdk := g.use(importPluginSdk)
+	lines := make([]string, 0)
+
+	switch p.kind {
+	case kindBool:
+		lines = append(lines, fmt.Sprintf("Type: %s.TypeBool", pluginsdk))
+	case kindInt:
+		lines = append(lines, fmt.Sprintf("Type: %s.TypeInt", pluginsdk))
+	case kindFloat:
+		lines = append(lines, fmt.Sprintf("Type: %s.TypeFloat", pluginsdk))
+	case kindStringMap:
+		lines = append(lines, fmt.Sprintf("Type: %s.TypeMap", pluginsdk))
+	case kindStringList, kindObject, kindObjectList:
+		lines = append(lines, fmt.Sprintf("Type: %s.TypeList", pluginsdk))
+	default:
+		lines = append(lines, fmt.Sprintf("Type: %s.TypeString", pluginsdk))
+	}
+
+	switch {
+	case p.computed:
+		lines = append(lines, "Computed: true")
+	case p.required:
+		lines = append(lines, "Required: true")
+	default:
+		lines = append(lines, "Optional: true")
+	}
+	if p.forceNew && !p.computed {
+		lines = append(lines, "ForceNew: true")
+	}
+	if p.kind == kindObject {
+		lines = append(lines, "MaxItems: 1")
+	}
+
+	if !p.computed {
+		switch p.kind {
+		case kindString:
+			lines = append(lines, fmt.Sprintf("ValidateFunc: %s.StringIsNotEmpty", g.use(importValidation)))
+		case kindConstant:
+			lines = append(lines, fmt.Sprintf("ValidateFunc: %s.StringInSlice(%s.PossibleValuesFor%s(), false)", g.use(importValidation), g.sdk(), p.sdkType))
+		}
+	}
+
+	switch p.kind {
+	case kindStringList:
+		element := fmt.Sprintf("Type: %s.TypeString,\n", pluginsdk)
+		if !p.computed {
+			element += fmt.Sprintf("ValidateFunc: %s.StringIsNotEmpty,\n", g.use(importValidation))
+		}
+		lines = append(lines, fmt.Sprintf("Elem: &%s.Schema{\n%s}", pluginsdk, element))
+	case kindStringMap:
+		lines = append(lines, fmt.Sprintf("Elem: &%s.Schema{\nType: %s.TypeString,\n}", pluginsdk, pluginsdk))
+	case kindObject, kindObjectList:
+		nested := make([]string, 0)
+		for _, v := range p.object.properties {
+			v.computed = p.computed
+			v.forceNew = p.forceNew
+			nested = append(nested, fmt.Sprintf("%q: %s,\n", v.hclName, g.schemaFor(v)))
+		}
+		lines = append(lines, fmt.Sprintf("Elem: &%s.Resource{\nSchema: map[string]*%s.Schema{\n%s},\n}", pluginsdk, pluginsdk, strings.Join(nested, "\n")))
+	}
+
+	return fmt.Sprintf("{\n%s,\n}", strings.Join(lines, ",\n"))
+}
+
+func (g *generator) writeCreate() {
+	sdk := g.use(importSdk)
+	g.use("context")
+	g.use("fmt")
+	g.use("time")
+
+	g.printf("func (r %s) Create() %s.ResourceFunc {\nreturn %s.ResourceFunc{\nTimeout: 30 * time.Minute,\n", g.resourceName(), sdk, sdk)
+	g.printf("Func: func(ctx context.Context, metadata %s.ResourceMetaData) error {\n", sdk)
+	g.printf("client := metadata.Client.%s\n", g.d.client)
+	if g.d.hasSubscriptionId() {
+		g.printf("subscriptionId := metadata.Client.Account.SubscriptionId\n")
+	}
+	g.printf("\nvar config %s\nif err := metadata.Decode(&config); err != nil {\nreturn fmt.Errorf(\"decoding: %%+v\", err)\n}\n\n", g.modelName())
+
+	args := make([]string, 0)
+	for _, v := range g.d.idSegments {
+		if v.fieldName == "SubscriptionId" {
+			args = append(args, "subscriptionId")
+			continue
+		}
+		args = append(args, fmt.Sprintf("config.%s", snakeToCamel(v.hclName)))
+	}
+	g.printf("id := %s.New%sID(%s)\n\n", g.sdk(), strings.TrimSuffix(g.d.idType, "Id"), strings.Join(args, ", "))
+
+	response := g.use(importResponse)
+	g.printf("existing, err := client.Get(%s)\n", g.operationArgs(g.d.get, "id", ""))
+	g.printf("if err != nil && !%s.WasNotFound(existing.HttpResponse) {\nreturn fmt.Errorf(\"checking for the presence of an existing %%s: %%+v\", id, err)\n}\n", response)
+	g.printf("if !%s.WasNotFound(existing.HttpResponse) {\nreturn metadata.ResourceRequiresImport(r.ResourceType(), id)\n}\n\n", response)
+
+	g.printf("payload := %s.%s{\n", g.sdk(), g.d.modelType)
+	if g.d.hasLocation {
+		expression := fmt.Sprintf("%s.Normalize(config.Location)", g.use(importLocation))
+		if g.d.locationPointer {
+			expression = fmt.Sprintf("%s.To(%s)", g.use(importPointer), expression)
+		}
+		g.printf("Location: %s,\n", expression)
+	}
+	properties := make([]string, 0)
+	for _, p := range g.d.properties {
+		if p.computed {
+			continue
+		}
+		value := fmt.Sprintf("%s: %s,\n", p.fieldName, g.expandExpression(p, "config."+p.fieldName))
+		if p.inProperties {
+			properties = append(properties, value)
+			continue
+		}
+		g.printf("%s", value)
+	}
+	if g.d.propertiesType != "" {
+		prefix := ""
+		if g.d.propertiesPointer {
+			prefix = "&"
+		}
+		g.printf("Properties: %s%s.%s{\n%s},\n", prefix, g.sdk(), g.d.propertiesType, strings.Join(properties, ""))
+	}
+	if g.d.hasTags {
+		g.printf("Tags: %s,\n", g.tagsExpression("config.Tags"))
+	}
+	g.printf("}\n\n")
+
+	g.writeOperation(g.d.create, "id", "payload", "creating")
+	g.printf("\nmetadata.SetID(id)\nreturn nil\n},\n}\n}\n\n")
+}
+
+func (g *generator) writeRead() {
+	sdk := g.use(importSdk)
+	g.printf("func (r %s) Read() %s.ResourceFunc {\nreturn %s.ResourceFunc{\nTimeout: 5 * time.Minute,\n", g.resourceName(), sdk, sdk)
+	g.printf("Func: func(ctx context.Context, metadata %s.ResourceMetaData) error {\n", sdk)
+	g.printf("client := metadata.Client.%s\n\n", g.d.client)
+	g.writeParseID()
+
+	g.printf("resp, err := client.Get(%s)\n", g.operationArgs(g.d.get, "*id", ""))
+	g.printf("if err != nil {\nif %s.WasNotFound(resp.HttpResponse) {\nreturn metadata.MarkAsGone(id)\n}\n\nreturn fmt.Errorf(\"retrieving %%s: %%+v\", *id, err)\n}\n\n", g.use(importResponse))
+
+	g.printf("state := %s{\n", g.modelName())
+	for _, v := range g.d.schemaIdSegments() {
+		g.printf("%s: id.%s,\n", snakeToCamel(v.hclName), v.fieldName)
+	}
+	g.printf("}\n\n")
+
+	g.printf("if model := resp.Model; model != nil {\n")
+	if g.d.hasLocation {
+		if g.d.locationPointer {
+			g.printf("state.Location = %s.NormalizeNilable(model.Location)\n", g.use(importLocation))
+		} else {
+			g.printf("state.Location = %s.Normalize(model.Location)\n", g.use(importLocation))
+		}
+	}
+	for _, p := range g.d.properties {
+		if !p.inProperties {
+			g.printf("state.%s = %s\n", p.fieldName, g.flattenExpression(p, "model."+p.fieldName))
+		}
+	}
+	if g.d.propertiesType != "" {
+		properties := make([]string, 0)
+		for _, p := range g.d.properties {
+			if p.inProperties {
+				properties = append(properties, fmt.Sprintf("state.%s = %s\n", p.fieldName, g.flattenExpression(p, "props."+p.fieldName)))
+			}
+		}
+		if len(properties) > 0 {
+			if g.d.propertiesPointer {
+				g.printf("\nif props := model.Properties; props != nil {\n%s}\n", strings.Join(properties, ""))
+			} else {
+				g.printf("\nprops := model.Properties\n%s", strings.Join(properties, ""))
+			}
+		}
+	}
+	if g.d.hasTags {
+		if g.d.tagsPointer {
+			g.printf("\nstate.Tags = %s.From(model.Tags)\n", g.use(importPointer))
+		} else {
+			g.printf("\nstate.Tags = model.Tags\n")
+		}
+	}
+	g.printf("}\n\nreturn metadata.Encode(&state)\n},\n}\n}\n\n")
+}
+
+func (g *generator) writeUpdate() {
+	if !g.hasUpdate() {
+		return
+	}
+
+	sdk := g.use(importSdk)
+	g.printf("func (r %s) Update() %s.ResourceFunc {\nreturn %s.ResourceFunc{\nTimeout: 30 * time.Minute,\n", g.resourceName(), sdk, sdk)
+	g.printf("Func: func(ctx context.Context, metadata %s.ResourceMetaData) error {\n", sdk)
+	g.printf("client := metadata.Client.%s\n\n", g.d.client)
+	g.writeParseID()
+	g.printf("var config %s\nif err := metadata.Decode(&config); err != nil {\nreturn fmt.Errorf(\"decoding: %%+v\", err)\n}\n\n", g.modelName())
+
+	g.printf("existing, err := client.Get(%s)\n", g.operationArgs(g.d.get, "*id", ""))
+	g.printf("if err != nil {\nreturn fmt.Errorf(\"retrieving %%s: %%+v\", *id, err)\n}\n")
+	g.printf("if existing.Model == nil {\nreturn fmt.Errorf(\"retrieving %%s: `model` was nil\", *id)\n}\n")
+	g.printf("payload := *existing.Model\n\n")
+
+	updatable := g.d.updatableProperties()
+	if g.d.propertiesPointer {
+		for _, p := range updatable {
+			if p.inProperties {
+				g.printf("if payload.Properties == nil {\npayload.Properties = &%s.%s{}\n}\n\n", g.sdk(), g.d.propertiesType)
+				break
+			}
+		}
+	}
+	for _, p := range updatable {
+		field := "payload." + p.fieldName
+		if p.inProperties {
+			field = "payload.Properties." + p.fieldName
+		}
+		g.printf("if metadata.ResourceData.HasChange(%q) {\n%s = %s\n}\n\n", p.hclName, field, g.expandExpression(p, "config."+p.fieldName))
+	}
+	if g.d.hasTags {
+		g.printf("if metadata.ResourceData.HasChange(\"tags\") {\npayload.Tags = %s\n}\n\n", g.tagsExpression("config.Tags"))
+	}
+
+	g.writeOperation(*g.d.update, "*id", "payload", "updating")
+	g.printf("\nreturn nil\n},\n}\n}\n\n")
+}
+
+func (g *generator) writeDelete() {
+	sdk := g.use(importSdk)
+	g.printf("func (r %s) Delete() %s.ResourceFunc {\nreturn %s.ResourceFunc{\nTimeout: 30 * time.Minute,\n", g.resourceName(), sdk, sdk)
+	g.printf("Func: func(ctx context.Context, metadata %s.ResourceMetaData) error {\n", sdk)
+	g.printf("client := metadata.Client.%s\n\n", g.d.client)
+	g.writeParseID()
+	g.writeOperation(g.d.delete, "*id", "", "deleting")
+	g.printf("\nreturn nil\n},\n}\n}\n\n")
+}
+
+func (g *generator) writeParseID() {
+	g.printf("id, err := %s.Parse%sID(metadata.ResourceData.Id())\nif err != nil {\nreturn err\n}\n\n", g.sdk(), strings.TrimSuffix(g.d.idType, "Id"))
+}
+
+func (g *generator) writeOperation(op operation, id, payload, description string) {
+	call := fmt.Sprintf("client.%s(%s)", op.method, g.operationArgs(op, id, payload))
+	if op.returnsResult {
+		g.printf("if _, err := %s; err != nil {\n", call)
+	} else {
+		g.printf("if err := %s; err != nil {\n", call)
+	}
+	g.printf("return fmt.Errorf(\"%s %%s: %%+v\", %s, err)\n}\n", description, id)
+}
+
+func (g *generator) operationArgs(op operation, id, payload string) string {
+	args := []string{"ctx", id}
+	if payload != "" {
+		args = append(args, payload)
+	}
+	if op.options != "" {
+		g.sdk()
+		args = append(args, op.options)
+	}
+	return strings.Join(args, ", ")
+}
+
+func (g *generator) tagsExpression(input string) string {
+	if g.d.tagsPointer {
+		return fmt.Sprintf("%s.To(%s)", g.use(importPointer), input)
+	}
+	return input
+}
+
+// expandExpression returns the expression to convert the Typed Model value `input` into the SDK value for p
+func (g *generator) expandExpression(p property, input string) string {
+	switch p.kind {
+	case kindConstant:
+		input = fmt.Sprintf("%s.%s(%s)", g.sdk(), p.sdkType, input)
+	case kindObject:
+		input = fmt.Sprintf("expand%s%s(%s)", g.d.name, p.sdkType, input)
+		if !p.sdkPointer {
+			return fmt.Sprintf("%s.From(%s)", g.use(importPointer), input)
+		}
+		return input
+	case kindObjectList:
+		input = fmt.Sprintf("expand%s%sArray(%s)", g.d.name, p.sdkType, input)
+		if !p.sdkPointer {
+			return fmt.Sprintf("%s.From(%s)", g.use(importPointer), input)
+		}
+		return input
+	}
+
+	if p.sdkPointer {
+		return fmt.Sprintf("%s.To(%s)", g.use(importPointer), input)
+	}
+	return input
+}
+
+// flattenExpression returns the expression to convert the SDK value `input` into the Typed Model value for p
+func (g *generator) flattenExpression(p property, input string) string {
+	switch p.kind {
+	case kindConstant:
+		if p.sdkPointer {
+			return fmt.Sprintf("string(%s.From(%s))", g.use(importPointer), input)
+		}
+		return fmt.Sprintf("string(%s)", input)
+	case kindObject, kindObjectList:
+		function := fmt.Sprintf("flatten%s%s", g.d.name, p.sdkType)
+		if p.kind == kindObjectList {
+			function += "Array"
+		}
+		if !p.sdkPointer {
+			input = "&" + input
+		}
+		return fmt.Sprintf("%s(%s)", function, input)
+	}
+
+	if p.sdkPointer {
+		return fmt.Sprintf("%s.From(%s)", g.use(importPointer), input)
+	}
+	return input
+}
+
+func (g *generator) writeExpandAndFlatten() {
+	for _, o := range g.d.sortedObjects() {
+		model := g.objectModelName(o)
+		sdkType := fmt.Sprintf("%s.%s", g.sdk(), o.sdkType)
+
+		expand := make([]string, 0)
+		flatten := make([]string, 0)
+		for _, p := range o.properties {
+			expand = append(expand, fmt.Sprintf("%s: %s,\n", p.fieldName, g.expandExpression(p, "v."+p.fieldName)))
+			flatten = append(flatten, fmt.Sprintf("%s: %s,\n", p.fieldName, g.flattenExpression(p, "v."+p.fieldName)))
+		}
+
+		if o.usedAsObject {
+			g.printf("func expand%s%s(input []%s) *%s {\nif len(input) == 0 {\nreturn nil\n}\n\n", g.d.name, o.sdkType, model, sdkType)
+			g.printf("v := input[0]\nreturn &%s{\n%s}\n}\n\n", sdkType, strings.Join(expand, ""))
+
+			g.printf("func flatten%s%s(input *%s) []%s {\nif input == nil {\nreturn []%s{}\n}\n\n", g.d.name, o.sdkType, sdkType, model, model)
+			g.printf("v := *input\nreturn []%s{\n{\n%s},\n}\n}\n\n", model, strings.Join(flatten, ""))
+		}
+
+		if o.usedAsList {
+			g.printf("func expand%s%sArray(input []%s) *[]%s {\noutput := make([]%s, 0)\n", g.d.name, o.sdkType, model, sdkType, sdkType)
+			g.printf("for _, v := range input {\noutput = append(output, %s{\n%s})\n}\n\nreturn &output\n}\n\n", sdkType, strings.Join(expand, ""))
+
+			g.printf("func flatten%s%sArray(input *[]%s) []%s {\noutput := make([]%s, 0)\nif input == nil {\nreturn output\n}\n\n", g.d.name, o.sdkType, sdkType, model, model)
+			g.printf("for _, v := range *input {\noutput = append(output, %s{\n%s})\n}\n\nreturn output\n}\n\n", model, strings.Join(flatten, ""))
+		}
+	}
+}
@@ -0,0 +1,142 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// sdkPackage is the parsed source of a go-azure-sdk API Version package, e.g. `dnsresolvers`
+type sdkPackage struct {
+	importPath string
+	name       string
+
+	// types is a map of Type Name to the Declaration of that Type
+	types map[string]*ast.TypeSpec
+
+	// functions is a map of Function Name to the Declaration of that (top-level) Function
+	functions map[string]*ast.FuncDecl
+
+	// methods is a map of Type Name to Method Name to the Declaration of that Method
+	methods map[string]map[string]*ast.FuncDecl
+}
+
+func loadPackage(directory, importPath string) (*sdkPackage, error) {
+	entries, err := os.ReadDir(directory)
+	if err != nil {
+		return nil, fmt.Errorf("reading %q: %+v", directory, err)
+	}
+
+	pkg := sdkPackage{
+		importPath: importPath,
+		types:      map[string]*ast.TypeSpec{},
+		functions:  map[string]*ast.FuncDecl{},
+		methods:    map[string]map[string]*ast.FuncDecl{},
+	}
+	fileSet := token.NewFileSet()
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), ".go") || strings.HasSuffix(entry.Name(), "_test.go") {
+			continue
+		}
+
+		file, err := parser.ParseFile(fileSet, filepath.Join(directory, entry.Name()), nil, 0)
+		if err != nil {
+			return nil, fmt.Errorf("parsing %q: %+v", entry.Name(), err)
+		}
+		pkg.name = file.Name.Name
+
+		for _, decl := range file.Decls {
+			switch d := decl.(type) {
+			case *ast.FuncDecl:
+				if d.Recv == nil || len(d.Recv.List) == 0 {
+					pkg.functions[d.Name.Name] = d
+					continue
+				}
+
+				typeName := identName(d.Recv.List[0].Type)
+				if _, ok := pkg.methods[typeName]; !ok {
+					pkg.methods[typeName] = map[string]*ast.FuncDecl{}
+				}
+				pkg.methods[typeName][d.Name.Name] = d
+
+			case *ast.GenDecl:
+				for _, spec := range d.Specs {
+					if typeSpec, ok := spec.(*ast.TypeSpec); ok {
+						pkg.types[typeSpec.Name.Name] = typeSpec
+					}
+				}
+			}
+		}
+	}
+
+	if pkg.name == "" {
+		return nil, fmt.Errorf("no Go files were found in %q", directory)
+	}
+
+	return &pkg, nil
+}
+
+// structType returns the declaration of the struct `name`, or nil if this isn't a struct
+func (p *sdkPackage) structType(name string) *ast.StructType {
+	spec, ok := p.types[name]
+	if !ok {
+		return nil
+	}
+	v, _ := spec.Type.(*ast.StructType)
+	return v
+}
+
+// isConstant returns whether `name` is a constant type (e.g. `type SkuName string`) which has a
+// list of possible values
+func (p *sdkPackage) isConstant(name string) bool {
+	_, ok := p.functions[fmt.Sprintf("PossibleValuesFor%s", name)]
+	return ok
+}
+
+// idTypes returns the names of the Resource ID types defined within this package, e.g. `DnsResolverId`
+func (p *sdkPackage) idTypes() []string {
+	output := make([]string, 0)
+	for name := range p.functions {
+		if !strings.HasPrefix(name, "Parse") || !strings.HasSuffix(name, "ID") {
+			continue
+		}
+		idType := strings.TrimSuffix(strings.TrimPrefix(name, "Parse"), "ID") + "Id"
+		if p.structType(idType) != nil {
+			output = append(output, idType)
+		}
+	}
+	sort.Strings(output)
+	return output
+}
+
+// clientType returns the name of the Client type defined within this package, e.g. `DnsResolversClient`
+func (p *sdkPackage) clientType() (string, error) {
+	clients := make([]string, 0)
+	for name := range p.types {
+		if strings.HasSuffix(name, "Client") && p.structType(name) != nil {
+			clients = append(clients, name)
+		}
+	}
+	if len(clients) != 1 {
+		return "", fmt.Errorf("expected a single Client within %q but found %d", p.importPath, len(clients))
+	}
+	return clients[0], nil
+}
+
+func identName(input ast.Expr) string {
+	switch v := input.(type) {
+	case *ast.Ident:
+		return v.Name
+	case *ast.StarExpr:
+		return identName(v.X)
+	}
+	return ""
+}
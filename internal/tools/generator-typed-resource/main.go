@@ -0,0 +1,94 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+func main() {
+	f := flag.NewFlagSet("generator-typed-resource", flag.ExitOnError)
+
+	sdkPackage := f.String("sdk-package", "", "the import path of the go-azure-sdk API Version package containing the model, e.g. `github.com/hashicorp/go-azure-sdk/resource-manager/dnsresolver/2022-07-01/dnsresolvers`")
+	model := f.String("model", "", "the name of the model within the SDK package to generate the resource from, e.g. `DnsResolver`")
+	resourceType := f.String("resource-type", "", "the name of the Terraform Resource, e.g. `azurerm_private_dns_resolver`")
+	client := f.String("client", "", "the path to the SDK client from `metadata.Client`, e.g. `PrivateDnsResolver.DnsResolversClient`")
+	id := f.String("id", "", "the name of the Resource ID type within the SDK package, only required when the package contains more than one")
+	attributes := f.String("attributes", "", "a comma separated list of the properties which are read-only and should be output as Attributes, e.g. `resource_guid`")
+	packageName := f.String("package", "", "the name of the Go package for the generated code, defaults to the name of the service package")
+	output := f.String("output", "", "the path to write the generated resource to, defaults to stdout")
+
+	if err := f.Parse(os.Args[1:]); err != nil {
+		log.Fatalf("parsing args: %+v", err)
+	}
+	if *sdkPackage == "" || *model == "" || *resourceType == "" || *client == "" {
+		fmt.Fprintln(os.Stderr, "Usage: generator-typed-resource -sdk-package <import-path> -model <model> -resource-type <resource_type> -client <client>")
+		f.PrintDefaults()
+		os.Exit(1)
+	}
+
+	directory, err := vendoredPackageDirectory(*sdkPackage)
+	if err != nil {
+		log.Fatalf("locating %q: %+v", *sdkPackage, err)
+	}
+	pkg, err := loadPackage(directory, *sdkPackage)
+	if err != nil {
+		log.Fatalf("loading %q: %+v", *sdkPackage, err)
+	}
+
+	computed := make([]string, 0)
+	for _, v := range strings.Split(*attributes, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			computed = append(computed, v)
+		}
+	}
+
+	definition, err := buildResourceDefinition(pkg, *resourceType, *model, *id, *client, computed)
+	if err != nil {
+		log.Fatalf("building resource definition: %+v", err)
+	}
+
+	if *packageName == "" {
+		*packageName = strings.ToLower(strings.Split(*client, ".")[0])
+	}
+	code, err := generate(definition, *packageName)
+	if err != nil {
+		log.Fatalf("generating resource: %+v", err)
+	}
+
+	if *output == "" {
+		fmt.Print(string(code))
+		return
+	}
+	if err := os.WriteFile(*output, code, 0o644); err != nil {
+		log.Fatalf("writing %q: %+v", *output, err)
+	}
+}
+
+// vendoredPackageDirectory returns the directory containing the vendored copy of the package importPath,
+// searching upwards from the current working directory for the `vendor` directory
+func vendoredPackageDirectory(importPath string) (string, error) {
+	directory, err := os.Getwd()
+	if err != nil {
+		return "", err
+	}
+
+	for {
+		candidate := filepath.Join(directory, "vendor", filepath.FromSlash(importPath))
+		if info, err := os.Stat(candidate); err == nil && info.IsDir() {
+			return candidate, nil
+		}
+
+		parent := filepath.Dir(directory)
+		if parent == directory {
+			return "", fmt.Errorf("the package wasn't found within the `vendor` directory - ensure it's been vendored using `go mod vendor`")
+		}
+		directory = parent
+	}
+}
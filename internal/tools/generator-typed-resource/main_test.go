@@ -0,0 +1,92 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"go/parser"
+	"go/token"
+	"strings"
+	"testing"
+)
+
+func TestGenerate(t *testing.T) {
+	pkg, err := loadPackage("testdata/widgets", "github.com/hashicorp/go-azure-sdk/resource-manager/example/2024-01-01/widgets")
+	if err != nil {
+		t.Fatalf("loading package: %+v", err)
+	}
+
+	definition, err := buildResourceDefinition(pkg, "azurerm_example_widget", "Widget", "", "Example.WidgetsClient", []string{"resource_guid"})
+	if err != nil {
+		t.Fatalf("building resource definition: %+v", err)
+	}
+
+	code, err := generate(definition, "example")
+	if err != nil {
+		t.Fatalf("generating: %+v", err)
+	}
+	if _, err := parser.ParseFile(token.NewFileSet(), "example.go", code, 0); err != nil {
+		t.Fatalf("parsing generated code: %+v\n\n%s", err, string(code))
+	}
+
+	// the generated code is aligned by gofmt, so whitespace is collapsed before comparing
+	actual := collapseWhitespace(string(code))
+	expected := []string{
+		// the Typed Model, including the parent segment from the Resource ID
+		"Name string `tfschema:\"name\"`",
+		"FactoryName string `tfschema:\"factory_name\"`",
+		"Parts []ExampleWidgetPartModel `tfschema:\"parts\"`",
+		// the recursive `children` field is skipped
+		"// - Part.Children",
+		"// - Widget.Identity",
+		// the Create API doesn't support updates and the Update API uses a Patch model, so everything is ForceNew
+		"var _ sdk.Resource = ExampleWidgetResource{}",
+		"return widgets.ValidateWidgetID",
+		"ValidateFunc: validation.StringInSlice(widgets.PossibleValuesForSkuName(), false),",
+		`"resource_guid": { Type: pluginsdk.TypeString, Computed: true, },`,
+		"id := widgets.NewWidgetID(subscriptionId, config.ResourceGroupName, config.FactoryName, config.Name)",
+		"Location: pointer.To(location.Normalize(config.Location)),",
+		"Properties: &widgets.WidgetProperties{",
+		"Parts: expandExampleWidgetPartArray(config.Parts),",
+		"Sku: widgets.SkuName(config.Sku),",
+		"if err := client.CreateThenPoll(ctx, id, payload, widgets.DefaultCreateOperationOptions()); err != nil {",
+		"return metadata.MarkAsGone(id)",
+		"state.Location = location.NormalizeNilable(model.Location)",
+		"state.Sku = string(model.Sku)",
+		"if props := model.Properties; props != nil {",
+		"if _, err := client.Delete(ctx, *id); err != nil {",
+		"func flattenExampleWidgetPartArray(input *[]widgets.Part) []ExampleWidgetPartModel {",
+	}
+	for _, v := range expected {
+		if !strings.Contains(actual, v) {
+			t.Fatalf("expected the generated code to contain %q:\n\n%s", v, string(code))
+		}
+	}
+
+	for _, v := range []string{"func (r ExampleWidgetResource) Update()", "provisioning_state", "ProvisioningState"} {
+		if strings.Contains(actual, v) {
+			t.Fatalf("expected the generated code not to contain %q:\n\n%s", v, string(code))
+		}
+	}
+}
+
+func TestConvertToSnakeCase(t *testing.T) {
+	cases := map[string]string{
+		"Name":                  "name",
+		"ResourceGroupName":     "resource_group_name",
+		"Ipv4Address":           "ipv4_address",
+		"SKUName":               "sku_name",
+		"KeyEncryptionKeyUrl":   "key_encryption_key_url",
+		"DisableLocalAuth":      "disable_local_auth",
+		"PublicNetworkAccessID": "public_network_access_id",
+	}
+	for input, expected := range cases {
+		if actual := convertToSnakeCase(input); actual != expected {
+			t.Fatalf("expected %q to be %q but got %q", input, expected, actual)
+		}
+	}
+}
+
+func collapseWhitespace(input string) string {
+	return strings.Join(strings.Fields(input), " ")
+}
@@ -0,0 +1,95 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package widgets
+
+import (
+	"context"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
+)
+
+type WidgetId struct {
+	SubscriptionId    string
+	ResourceGroupName string
+	FactoryName       string
+	WidgetName        string
+}
+
+func NewWidgetID(subscriptionId string, resourceGroupName string, factoryName string, widgetName string) WidgetId {
+	return WidgetId{}
+}
+
+func ParseWidgetID(input string) (*WidgetId, error) {
+	return nil, nil
+}
+
+func ValidateWidgetID(input interface{}, key string) (warnings []string, errors []error) {
+	return
+}
+
+type SkuName string
+
+func PossibleValuesForSkuName() []string {
+	return []string{}
+}
+
+type Widget struct {
+	Id         *string                            `json:"id,omitempty"`
+	Identity   *identity.SystemAndUserAssignedMap `json:"identity,omitempty"`
+	Location   *string                            `json:"location,omitempty"`
+	Name       *string                            `json:"name,omitempty"`
+	Properties *WidgetProperties                  `json:"properties,omitempty"`
+	Sku        SkuName                            `json:"sku"`
+	Tags       *map[string]string                 `json:"tags,omitempty"`
+}
+
+type WidgetProperties struct {
+	Enabled           *bool              `json:"enabled,omitempty"`
+	Parts             *[]Part            `json:"parts,omitempty"`
+	ProvisioningState *string            `json:"provisioningState,omitempty"`
+	ResourceGuid      *string            `json:"resourceGuid,omitempty"`
+	Settings          *map[string]string `json:"settings,omitempty"`
+}
+
+type Part struct {
+	Children *[]Part  `json:"children,omitempty"`
+	Name     string   `json:"name"`
+	Weight   *float64 `json:"weight,omitempty"`
+}
+
+type WidgetsClient struct{}
+
+type CreateOperationOptions struct{}
+
+func DefaultCreateOperationOptions() CreateOperationOptions {
+	return CreateOperationOptions{}
+}
+
+func (c WidgetsClient) CreateThenPoll(ctx context.Context, id WidgetId, input Widget, options CreateOperationOptions) error {
+	return nil
+}
+
+func (c WidgetsClient) Get(ctx context.Context, id WidgetId) (result GetOperationResponse, err error) {
+	return
+}
+
+func (c WidgetsClient) Update(ctx context.Context, id WidgetId, input WidgetPatch) (result UpdateOperationResponse, err error) {
+	return
+}
+
+func (c WidgetsClient) Delete(ctx context.Context, id WidgetId) (result DeleteOperationResponse, err error) {
+	return
+}
+
+type GetOperationResponse struct {
+	Model *Widget
+}
+
+type UpdateOperationResponse struct{}
+
+type DeleteOperationResponse struct{}
+
+type WidgetPatch struct {
+	Tags *map[string]string `json:"tags,omitempty"`
+}
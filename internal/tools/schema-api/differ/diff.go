@@ -5,114 +5,152 @@ package differ
 
 import (
 	"fmt"
+	"reflect"
+	"sort"
 
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tools/schema-api/providerjson"
 	schema_rules "github.com/hashicorp/terraform-provider-azurerm/internal/tools/schema-api/schema-rules"
 )
 
+const (
+	KindDataSource = "data source"
+	KindResource   = "resource"
+)
+
+// Violation is a breaking change detected between the base (released) schema and the current schema
+type Violation struct {
+	// Kind is either `resource` or `data source`
+	Kind string `json:"kind"`
+
+	// Name is the name of the Resource or Data Source, for example `azurerm_resource_group`
+	Name string `json:"name"`
+
+	// Property is the path to the property within the Resource or Data Source, for example `identity.type`
+	Property string `json:"property"`
+
+	// Rule is the name of the BreakingChangeRule which was violated
+	Rule string `json:"rule"`
+
+	Message string `json:"message"`
+}
+
+func (v Violation) String() string {
+	return fmt.Sprintf("%s %q: %s", v.Kind, v.Name, v.Message)
+}
+
 type Differ struct {
 	base    *providerjson.ProviderWrapper
 	current *providerjson.ProviderWrapper
 }
 
-func (d *Differ) Diff(fileName string, providerName string) []string {
+func (d *Differ) Diff(fileName string, providerName string) ([]Violation, error) {
 	if err := d.loadFromProvider(providerjson.LoadData(), providerName); err != nil {
-		return []string{err.Error()}
+		return nil, err
 	}
 
 	if err := d.loadFromFile(fileName); err != nil {
-		return []string{err.Error()}
+		return nil, err
 	}
 
 	if d.base.ProviderName != d.current.ProviderName {
-		return []string{fmt.Sprintf("provider name mismatch, expected %q, got %q", d.base.ProviderName, d.current.ProviderName)}
+		return nil, fmt.Errorf("provider name mismatch, expected %q, got %q", d.base.ProviderName, d.current.ProviderName)
 	}
 
-	violations := make([]string, 0)
+	return d.compare(), nil
+}
+
+func (d *Differ) compare() []Violation {
+	violations := make([]Violation, 0)
+
+	// schemas written prior to version 2 don't include the validation of properties, so can't be compared
+	ignoreValidation := d.base.SchemaVersion == "" || d.base.SchemaVersion == "1"
 
 	for resource, rs := range d.current.ProviderSchema.ResourcesMap {
-		_, ok := d.base.ProviderSchema.ResourcesMap[resource]
+		base, ok := d.base.ProviderSchema.ResourcesMap[resource]
 		if !ok {
 			// New resource, no breaking changes to worry about
 			continue
 		}
-		for propertyName, propertySchema := range rs.Schema {
-			// Get the same from the base (released) json
-			baseItem, ok := d.base.ProviderSchema.ResourcesMap[resource].Schema[propertyName]
-			if !ok {
-				// New property, could be breaking - Required etc
-				baseItem = providerjson.SchemaJSON{}
-			}
-			if errs := compareNodeResource(baseItem, propertySchema, propertyName); errs != nil {
-				violations = append(violations, errs...)
-			}
+		for _, v := range compareSchemas(base.Schema, rs.Schema, "", schema_rules.BreakingChangeRules, ignoreValidation) {
+			v.Kind = KindResource
+			v.Name = resource
+			violations = append(violations, v)
 		}
 	}
 
 	for dataSource, ds := range d.current.ProviderSchema.DataSourcesMap {
-		_, ok := d.base.ProviderSchema.DataSourcesMap[dataSource]
+		base, ok := d.base.ProviderSchema.DataSourcesMap[dataSource]
 		if !ok {
 			// New data source, no breaking changes to worry about
 			continue
 		}
-		for propertyName, propertySchema := range ds.Schema {
-			// Get the same from the base (released) json
-			baseItem, ok := d.base.ProviderSchema.DataSourcesMap[dataSource].Schema[propertyName]
-			if !ok {
-				// New property, could be breaking - Required etc
-				baseItem = providerjson.SchemaJSON{}
-			}
-			if errs := compareNodeDataSource(baseItem, propertySchema, propertyName); errs != nil {
-				violations = append(violations, errs...)
-			}
+		for _, v := range compareSchemas(base.Schema, ds.Schema, "", schema_rules.BreakingChangeRulesDataSource, ignoreValidation) {
+			v.Kind = KindDataSource
+			v.Name = dataSource
+			violations = append(violations, v)
 		}
 	}
 
+	sort.Slice(violations, func(i, j int) bool {
+		if violations[i].Kind != violations[j].Kind {
+			return violations[i].Kind > violations[j].Kind
+		}
+		if violations[i].Name != violations[j].Name {
+			return violations[i].Name < violations[j].Name
+		}
+		if violations[i].Property != violations[j].Property {
+			return violations[i].Property < violations[j].Property
+		}
+		return violations[i].Rule < violations[j].Rule
+	})
+
 	return violations
 }
 
-func compareNodeResource(base providerjson.SchemaJSON, current providerjson.SchemaJSON, nodeName string) (errs []string) {
-	if nodeIsBlock(base) {
-		newBaseRaw := base.Elem.(providerjson.ResourceJSON).Schema
-		newCurrent := current.Elem.(*providerjson.ResourceJSON).Schema
-		for k, newBase := range newBaseRaw {
-			errs = append(errs, compareNodeResource(newBase, newCurrent[k], k)...)
-		}
+// compareSchemas compares every property present in either the base or current schema - where a property is
+// missing from one of the schemas an empty SchemaJSON is compared, so that the rules can detect new and removed properties
+func compareSchemas(base map[string]providerjson.SchemaJSON, current map[string]providerjson.SchemaJSON, parentPath string, rules []schema_rules.BreakingChangeRule, ignoreValidation bool) (violations []Violation) {
+	propertyNames := make(map[string]struct{})
+	for k := range base {
+		propertyNames[k] = struct{}{}
+	}
+	for k := range current {
+		propertyNames[k] = struct{}{}
 	}
 
-	for _, v := range schema_rules.BreakingChangeRules {
-		if err := v.Check(base, current, nodeName); err != nil {
-			errs = append(errs, *err)
+	for propertyName := range propertyNames {
+		path := propertyName
+		if parentPath != "" {
+			path = fmt.Sprintf("%s.%s", parentPath, propertyName)
 		}
+		violations = append(violations, compareNode(base[propertyName], current[propertyName], path, rules, ignoreValidation)...)
 	}
 
-	return
+	return violations
 }
 
-func compareNodeDataSource(base providerjson.SchemaJSON, current providerjson.SchemaJSON, nodeName string) (errs []string) {
-	if nodeIsBlock(base) {
-		newBaseRaw := base.Elem.(providerjson.ResourceJSON).Schema
-		newCurrent := current.Elem.(*providerjson.ResourceJSON).Schema
-		for k, newBase := range newBaseRaw {
-			errs = append(errs, compareNodeDataSource(newBase, newCurrent[k], k)...)
-		}
+func compareNode(base providerjson.SchemaJSON, current providerjson.SchemaJSON, path string, rules []schema_rules.BreakingChangeRule, ignoreValidation bool) (violations []Violation) {
+	if ignoreValidation {
+		current.Validation = ""
 	}
 
-	for _, v := range schema_rules.BreakingChangeRulesDataSource {
-		if err := v.Check(base, current, nodeName); err != nil {
-			errs = append(errs, *err)
-		}
+	// nested properties are only compared when the block exists in both schemas - a removed block is reported once
+	// rather than for each nested property, and a new block can't break existing configurations unless it's Required
+	baseBlock, baseIsBlock := base.NestedSchema()
+	currentBlock, currentIsBlock := current.NestedSchema()
+	if baseIsBlock && currentIsBlock {
+		violations = append(violations, compareSchemas(baseBlock, currentBlock, path, rules, ignoreValidation)...)
 	}
 
-	return
-}
-
-func nodeIsBlock(input providerjson.SchemaJSON) bool {
-	if input.Type == providerjson.SchemaTypeList || input.Type == providerjson.SchemaTypeSet {
-		if _, ok := input.Elem.(providerjson.ResourceJSON); ok {
-			return true
+	for _, v := range rules {
+		if err := v.Check(base, current, path); err != nil {
+			violations = append(violations, Violation{
+				Property: path,
+				Rule:     reflect.TypeOf(v).Name(),
+				Message:  *err,
+			})
 		}
 	}
 
-	return false
+	return
 }
@@ -0,0 +1,203 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package differ
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tools/schema-api/providerjson"
+)
+
+// the base schema is loaded from a file, where nested blocks are a ResourceJSON, and the current schema
+// is loaded from the Provider, where nested blocks are a *ResourceJSON
+const testBaseSchema = `{
+  "providerName": "azurerm",
+  "schemaVersion": "2",
+  "providerSchema": {
+    "resources": {
+      "azurerm_example": {
+        "schema": {
+          "name": {"type": "TypeString", "required": true, "forceNew": true},
+          "sku": {"type": "TypeString", "optional": true},
+          "legacy": {"type": "TypeBool", "optional": true},
+          "network_rules": {
+            "type": "TypeList",
+            "optional": true,
+            "elem": {
+              "schema": {
+                "ip_rules": {"type": "TypeSet", "optional": true, "maxItems": 10, "elem": {"type": "TypeString"}},
+                "bypass": {
+                  "type": "TypeList",
+                  "optional": true,
+                  "elem": {
+                    "schema": {
+                      "services": {"type": "TypeString", "optional": true}
+                    }
+                  }
+                }
+              }
+            }
+          },
+          "removed_block": {
+            "type": "TypeList",
+            "optional": true,
+            "elem": {
+              "schema": {
+                "value": {"type": "TypeString", "optional": true}
+              }
+            }
+          }
+        }
+      }
+    },
+    "dataSources": {
+      "azurerm_example": {
+        "schema": {
+          "name": {"type": "TypeString", "required": true},
+          "sku": {"type": "TypeString", "computed": true}
+        }
+      }
+    }
+  }
+}`
+
+func TestDifferCompare(t *testing.T) {
+	base := &providerjson.ProviderWrapper{}
+	if err := json.Unmarshal([]byte(testBaseSchema), base); err != nil {
+		t.Fatalf("unmarshaling base schema: %+v", err)
+	}
+
+	current := &providerjson.ProviderWrapper{
+		ProviderName: "azurerm",
+		ProviderSchema: &providerjson.ProviderSchemaJSON{
+			ResourcesMap: map[string]providerjson.ResourceJSON{
+				"azurerm_example": {
+					Schema: map[string]providerjson.SchemaJSON{
+						"name": {Type: "TypeString", Required: true, ForceNew: true},
+						"sku":  {Type: "TypeString", Optional: true, ForceNew: true, Validation: "example.ValidateSku"},
+						"network_rules": {
+							Type:     "TypeList",
+							Optional: true,
+							Elem: &providerjson.ResourceJSON{
+								Schema: map[string]providerjson.SchemaJSON{
+									"ip_rules": {Type: "TypeSet", Optional: true, MaxItems: 5, Elem: providerjson.SchemaJSON{Type: "TypeString"}},
+									"bypass": {
+										Type:     "TypeList",
+										Optional: true,
+										Elem: &providerjson.ResourceJSON{
+											Schema: map[string]providerjson.SchemaJSON{
+												"services": {Type: "TypeString", Optional: true},
+												"enabled":  {Type: "TypeBool", Required: true},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			DataSourcesMap: map[string]providerjson.ResourceJSON{
+				"azurerm_example": {
+					Schema: map[string]providerjson.SchemaJSON{
+						"name": {Type: "TypeString", Required: true},
+					},
+				},
+			},
+		},
+	}
+
+	d := Differ{
+		base:    base,
+		current: current,
+	}
+	actual := d.compare()
+
+	expected := []Violation{
+		{Kind: KindResource, Name: "azurerm_example", Property: "legacy", Rule: "propertyRemoved"},
+		{Kind: KindResource, Name: "azurerm_example", Property: "network_rules.bypass.enabled", Rule: "newRequiredPropertyExistingResource"},
+		{Kind: KindResource, Name: "azurerm_example", Property: "network_rules.ip_rules", Rule: "maxItemsReduced"},
+		{Kind: KindResource, Name: "azurerm_example", Property: "removed_block", Rule: "propertyRemoved"},
+		{Kind: KindResource, Name: "azurerm_example", Property: "sku", Rule: "becomeForceNew"},
+		{Kind: KindResource, Name: "azurerm_example", Property: "sku", Rule: "validationChanged"},
+		{Kind: KindDataSource, Name: "azurerm_example", Property: "sku", Rule: "propertyRemoved"},
+	}
+	checkViolations(t, expected, actual)
+
+	// schemas written prior to version 2 don't include the validation, which therefore isn't compared
+	d.base.SchemaVersion = "1"
+	actual = d.compare()
+	checkViolations(t, append(expected[:5:5], expected[6]), actual)
+}
+
+func checkViolations(t *testing.T, expected []Violation, actual []Violation) {
+	t.Helper()
+
+	if len(actual) != len(expected) {
+		t.Fatalf("expected %d violations but got %d: %+v", len(expected), len(actual), actual)
+	}
+	for i, v := range expected {
+		a := actual[i]
+		if a.Kind != v.Kind || a.Name != v.Name || a.Property != v.Property || a.Rule != v.Rule {
+			t.Fatalf("expected violation %d to be %+v but got %+v", i, v, a)
+		}
+		if a.Message == "" {
+			t.Fatalf("expected violation %d to have a message", i)
+		}
+	}
+}
+
+func TestWriteReport(t *testing.T) {
+	violations := []Violation{
+		{
+			Kind:     KindResource,
+			Name:     "azurerm_example",
+			Property: "network_rules.ip_rules",
+			Rule:     "maxItemsReduced",
+			Message:  `Cannot lower the MaxItems of property "network_rules.ip_rules" (10 to 5)`,
+		},
+	}
+
+	buf := &bytes.Buffer{}
+	if err := WriteReport(buf, ReportFormatText, violations); err != nil {
+		t.Fatalf("writing text report: %+v", err)
+	}
+	if expected := `resource "azurerm_example": Cannot lower the MaxItems of property "network_rules.ip_rules" (10 to 5)` + "\n"; buf.String() != expected {
+		t.Fatalf("expected the text report %q but got %q", expected, buf.String())
+	}
+
+	buf.Reset()
+	if err := WriteReport(buf, ReportFormatJSON, violations); err != nil {
+		t.Fatalf("writing JSON report: %+v", err)
+	}
+	var actual []Violation
+	if err := json.Unmarshal(buf.Bytes(), &actual); err != nil {
+		t.Fatalf("unmarshaling JSON report: %+v", err)
+	}
+	if len(actual) != 1 || actual[0] != violations[0] {
+		t.Fatalf("expected the JSON report to contain %+v but got %+v", violations, actual)
+	}
+
+	buf.Reset()
+	if err := WriteReport(buf, ReportFormatSARIF, violations); err != nil {
+		t.Fatalf("writing SARIF report: %+v", err)
+	}
+	var sarif sarifLog
+	if err := json.Unmarshal(buf.Bytes(), &sarif); err != nil {
+		t.Fatalf("unmarshaling SARIF report: %+v", err)
+	}
+	if sarif.Version != "2.1.0" || len(sarif.Runs) != 1 || len(sarif.Runs[0].Results) != 1 || len(sarif.Runs[0].Tool.Driver.Rules) != 1 {
+		t.Fatalf("unexpected SARIF report: %s", buf.String())
+	}
+	if location := sarif.Runs[0].Results[0].Locations[0].LogicalLocations[0].FullyQualifiedName; location != "resource.azurerm_example.network_rules.ip_rules" {
+		t.Fatalf("expected the SARIF location to be %q but got %q", "resource.azurerm_example.network_rules.ip_rules", location)
+	}
+
+	if err := WriteReport(buf, "xml", violations); err == nil || !strings.Contains(err.Error(), "unsupported") {
+		t.Fatalf("expected an error for an unsupported format but got %+v", err)
+	}
+}
@@ -0,0 +1,146 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package differ
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+)
+
+const (
+	ReportFormatText  = "text"
+	ReportFormatJSON  = "json"
+	ReportFormatSARIF = "sarif"
+)
+
+// WriteReport writes the violations to w in the specified format
+func WriteReport(w io.Writer, format string, violations []Violation) error {
+	switch format {
+	case ReportFormatText:
+		for _, v := range violations {
+			if _, err := fmt.Fprintln(w, v.String()); err != nil {
+				return err
+			}
+		}
+		return nil
+
+	case ReportFormatJSON:
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(violations)
+
+	case ReportFormatSARIF:
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(sarifReport(violations))
+	}
+
+	return fmt.Errorf("unsupported report format %q, expected one of %q, %q or %q", format, ReportFormatText, ReportFormatJSON, ReportFormatSARIF)
+}
+
+// the subset of the SARIF 2.1.0 format needed to report violations
+// https://docs.oasis-open.org/sarif/sarif/v2.1.0/sarif-v2.1.0.html
+
+type sarifLog struct {
+	Schema  string     `json:"$schema"`
+	Version string     `json:"version"`
+	Runs    []sarifRun `json:"runs"`
+}
+
+type sarifRun struct {
+	Tool    sarifTool     `json:"tool"`
+	Results []sarifResult `json:"results"`
+}
+
+type sarifTool struct {
+	Driver sarifDriver `json:"driver"`
+}
+
+type sarifDriver struct {
+	Name  string      `json:"name"`
+	Rules []sarifRule `json:"rules"`
+}
+
+type sarifRule struct {
+	Id string `json:"id"`
+}
+
+type sarifResult struct {
+	RuleId    string          `json:"ruleId"`
+	Level     string          `json:"level"`
+	Message   sarifMessage    `json:"message"`
+	Locations []sarifLocation `json:"locations"`
+}
+
+type sarifMessage struct {
+	Text string `json:"text"`
+}
+
+type sarifLocation struct {
+	LogicalLocations []sarifLogicalLocation `json:"logicalLocations"`
+}
+
+type sarifLogicalLocation struct {
+	FullyQualifiedName string `json:"fullyQualifiedName"`
+	Kind               string `json:"kind"`
+}
+
+func sarifReport(violations []Violation) sarifLog {
+	ruleIds := make(map[string]struct{})
+	results := make([]sarifResult, 0)
+	for _, v := range violations {
+		ruleIds[v.Rule] = struct{}{}
+		results = append(results, sarifResult{
+			RuleId: v.Rule,
+			Level:  "error",
+			Message: sarifMessage{
+				Text: v.String(),
+			},
+			Locations: []sarifLocation{
+				{
+					LogicalLocations: []sarifLogicalLocation{
+						{
+							// e.g. `resource.azurerm_resource_group.tags`
+							FullyQualifiedName: fmt.Sprintf("%s.%s.%s", sarifKind(v.Kind), v.Name, v.Property),
+							Kind:               "member",
+						},
+					},
+				},
+			},
+		})
+	}
+
+	rules := make([]sarifRule, 0)
+	for id := range ruleIds {
+		rules = append(rules, sarifRule{Id: id})
+	}
+	sort.Slice(rules, func(i, j int) bool {
+		return rules[i].Id < rules[j].Id
+	})
+
+	return sarifLog{
+		Schema:  "https://json.schemastore.org/sarif-2.1.0.json",
+		Version: "2.1.0",
+		Runs: []sarifRun{
+			{
+				Tool: sarifTool{
+					Driver: sarifDriver{
+						Name:  "schema-api",
+						Rules: rules,
+					},
+				},
+				Results: results,
+			},
+		},
+	}
+}
+
+func sarifKind(kind string) string {
+	if kind == KindDataSource {
+		return "data"
+	}
+	return kind
+}
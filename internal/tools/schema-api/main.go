@@ -26,6 +26,8 @@ func main() {
 	exportSchema := f.String("export", "", "export the schema to the given path/filename. Intended for use in the release process")
 	detectBreakingChanges := f.String("detect", "", "compare current schema to named dump.")
 	errorOnBreakingChange := f.Bool("error-on-violation", false, "should the detect mode exit with a non-zero error code. Defaults to `false`")
+	reportFormat := f.String("report-format", differ.ReportFormatText, "the format of the violations reported by the detect mode, one of `text`, `json` or `sarif`")
+	reportFile := f.String("report-file", "", "the path/filename to write the violations reported by the detect mode to, defaults to stdout for `json` and `sarif`")
 
 	if err := f.Parse(os.Args[1:]); err != nil {
 		fmt.Printf("error parsing args: %+v", err)
@@ -41,7 +43,7 @@ func main() {
 			log.Printf("dumping schema for '%s'", *providerName)
 			wrappedProvider := &providerjson.ProviderWrapper{
 				ProviderName:  *providerName,
-				SchemaVersion: "1",
+				SchemaVersion: providerjson.SchemaVersion,
 			}
 			if err := providerjson.DumpWithWrapper(wrappedProvider, data); err != nil {
 				log.Fatalf("error dumping provider: %+v", err)
@@ -53,13 +55,17 @@ func main() {
 	case pointer.From(detectBreakingChanges) != "":
 		{
 			d := differ.Differ{}
-			if violations := d.Diff(*detectBreakingChanges, *providerName); violations != nil {
-				for _, v := range violations {
-					log.Println(v)
-				}
-				if pointer.From(errorOnBreakingChange) {
-					os.Exit(1)
-				}
+			violations, err := d.Diff(*detectBreakingChanges, *providerName)
+			if err != nil {
+				log.Fatalf("error detecting breaking changes: %+v", err)
+			}
+
+			if err := writeReport(*reportFormat, *reportFile, violations); err != nil {
+				log.Fatalf("error writing the %s report: %+v", *reportFormat, err)
+			}
+
+			if len(violations) > 0 && pointer.From(errorOnBreakingChange) {
+				os.Exit(1)
 			}
 
 			os.Exit(0)
@@ -70,7 +76,7 @@ func main() {
 			log.Printf("dumping schema for '%s'", *providerName)
 			wrappedProvider := &providerjson.ProviderWrapper{
 				ProviderName:  *providerName,
-				SchemaVersion: "1",
+				SchemaVersion: providerjson.SchemaVersion,
 			}
 			if err := providerjson.WriteWithWrapper(wrappedProvider, data, *exportSchema); err != nil {
 				log.Fatalf("error writing provider schema for %q to %q: %+v", *providerName, *exportSchema, err)
@@ -107,3 +113,25 @@ func main() {
 	log.Printf("starting api service on localhost:%d", *apiPort)
 	log.Println(http.ListenAndServe(fmt.Sprintf(":%d", *apiPort), mux))
 }
+
+func writeReport(format string, fileName string, violations []differ.Violation) error {
+	if fileName == "" {
+		if format == differ.ReportFormatText {
+			// retain the historical behaviour of logging each violation
+			for _, v := range violations {
+				log.Println(v)
+			}
+			return nil
+		}
+
+		return differ.WriteReport(os.Stdout, format, violations)
+	}
+
+	f, err := os.Create(fileName)
+	if err != nil {
+		return fmt.Errorf("creating %q: %+v", fileName, err)
+	}
+	defer f.Close()
+
+	return differ.WriteReport(f, format, violations)
+}
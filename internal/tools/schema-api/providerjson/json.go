@@ -5,9 +5,11 @@ package providerjson
 
 import (
 	"encoding/json"
+	"os"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/provider"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 )
 
 const (
@@ -33,6 +35,11 @@ type SchemaJSON struct {
 	Elem        interface{} `json:"elem,omitempty"`
 	MaxItems    int         `json:"maxItems,omitempty"`
 	MinItems    int         `json:"minItems,omitempty"`
+
+	// Validation is the name of the function used to validate this property, since the function itself can't be compared
+	Validation string `json:"validation,omitempty"`
+
+	Deprecated string `json:"deprecated,omitempty"`
 }
 
 func (b *SchemaJSON) UnmarshalJSON(body []byte) error {
@@ -52,8 +59,10 @@ func (b *SchemaJSON) UnmarshalJSON(body []byte) error {
 		b.MaxItems = int(max)
 	}
 	if min, ok := m["minItems"].(float64); ok {
-		b.MaxItems = int(min)
+		b.MinItems = int(min)
 	}
+	b.Validation, _ = m["validation"].(string)
+	b.Deprecated, _ = m["deprecated"].(string)
 
 	if def, ok := m["default"]; ok && def != nil {
 		switch def.(type) {
@@ -71,21 +80,40 @@ func (b *SchemaJSON) UnmarshalJSON(body []byte) error {
 	}
 
 	if e, ok := m["elem"]; ok && e != nil {
-		elem := e.(map[string]interface{})
-		if schema, ok := elem["schema"]; ok {
-			b.Elem = ResourceFromMap(schema.(map[string]interface{}))
-		}
-		if t, ok := elem["type"]; ok {
-			b.Elem = t.(string)
-		}
+		b.Elem = elemFromMap(e.(map[string]interface{}))
 	}
 
 	return nil
 }
 
+// NestedSchema returns the Schema of the nested block within this property, which is a ResourceJSON when loaded from
+// a file and a *ResourceJSON when loaded from the Provider
+func (b SchemaJSON) NestedSchema() (map[string]SchemaJSON, bool) {
+	if b.Type != SchemaTypeList && b.Type != SchemaTypeSet {
+		return nil, false
+	}
+
+	switch elem := b.Elem.(type) {
+	case ResourceJSON:
+		return elem.Schema, true
+	case *ResourceJSON:
+		if elem != nil {
+			return elem.Schema, true
+		}
+	}
+
+	return nil, false
+}
+
 type ResourceJSON struct {
 	Schema   map[string]SchemaJSON `json:"schema"`
 	Timeouts *ResourceTimeoutJSON  `json:"timeouts,omitempty"`
+
+	DeprecationMessage string `json:"deprecationMessage,omitempty"`
+
+	// DeprecatedInFavourOfResource is the Resource which replaces this Resource, for Typed Resources implementing
+	// sdk.ResourceWithDeprecationReplacedBy
+	DeprecatedInFavourOfResource string `json:"deprecatedInFavourOfResource,omitempty"`
 }
 
 type ResourceTimeoutJSON struct {
@@ -100,12 +128,47 @@ func LoadData() *ProviderJSON {
 	return (*ProviderJSON)(p)
 }
 
+// LoadFromFile loads a Provider schema previously written using WriteWithWrapper
+func LoadFromFile(fileName string) (*ProviderWrapper, error) {
+	f, err := os.Open(fileName)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	wrapper := &ProviderWrapper{}
+	// TODO - Custom marshalling to fix the type assertions later? meh, works for now...
+	if err := json.NewDecoder(f).Decode(wrapper); err != nil {
+		return nil, err
+	}
+
+	return wrapper, nil
+}
+
+// deprecatedInFavourOfResources returns a map of the Typed Resources which have been deprecated in favour of another
+// Resource, to the Resource which replaces them - since this isn't available from the Plugin SDK Resource
+func deprecatedInFavourOfResources() map[string]string {
+	output := make(map[string]string)
+	for _, service := range provider.SupportedTypedServices() {
+		for _, resource := range service.Resources() {
+			if v, ok := resource.(sdk.ResourceWithDeprecationReplacedBy); ok {
+				output[resource.ResourceType()] = v.DeprecatedInFavourOfResource()
+			}
+		}
+	}
+	return output
+}
+
 type ProviderSchemaJSON struct {
 	Schema         map[string]SchemaJSON   `json:"schema"`
 	ResourcesMap   map[string]ResourceJSON `json:"resources,omitempty"`
 	DataSourcesMap map[string]ResourceJSON `json:"dataSources,omitempty"`
 }
 
+// SchemaVersion is the version of the format used to write the Provider schema - version 2 added the validation and
+// deprecation of properties, and the deprecation of Resources
+const SchemaVersion = "2"
+
 type ProviderWrapper struct {
 	ProviderName   string              `json:"providerName"`
 	SchemaVersion  string              `json:"schemaVersion"`
@@ -5,6 +5,8 @@ package providerjson
 
 import (
 	"fmt"
+	"reflect"
+	"runtime"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -14,7 +16,9 @@ func resourceFromRaw(input *schema.Resource) (*ResourceJSON, error) {
 		return nil, fmt.Errorf("resource not found")
 	}
 
-	result := &ResourceJSON{}
+	result := &ResourceJSON{
+		DeprecationMessage: input.DeprecationMessage,
+	}
 	translatedSchema := make(map[string]SchemaJSON)
 
 	for k, s := range input.Schema {
@@ -57,6 +61,8 @@ func schemaFromRaw(input *schema.Schema) SchemaJSON {
 		Elem:        decodeElem(input.Elem),
 		MaxItems:    input.MaxItems,
 		MinItems:    input.MinItems,
+		Validation:  decodeValidation(input),
+		Deprecated:  input.Deprecated,
 	}
 }
 
@@ -99,7 +105,11 @@ func SchemaFromMap(input map[string]interface{}) SchemaJSON {
 	}
 
 	if t, ok := input["elem"]; ok {
-		result.Elem = decodeElem(t)
+		if elem, ok := t.(map[string]interface{}); ok {
+			result.Elem = elemFromMap(elem)
+		} else {
+			result.Elem = decodeElem(t)
+		}
 	}
 
 	if t, ok := input["minItems"]; ok {
@@ -110,6 +120,14 @@ func SchemaFromMap(input map[string]interface{}) SchemaJSON {
 		result.MaxItems = int(t.(float64))
 	}
 
+	if t, ok := input["validation"]; ok {
+		result.Validation = t.(string)
+	}
+
+	if t, ok := input["deprecated"]; ok {
+		result.Deprecated = t.(string)
+	}
+
 	return result
 }
 
@@ -123,6 +141,35 @@ func ResourceFromMap(input map[string]interface{}) ResourceJSON {
 	return result
 }
 
+// elemFromMap decodes the `elem` of a property loaded from a file, which is either a nested block or the type of the elements
+func elemFromMap(input map[string]interface{}) interface{} {
+	if schema, ok := input["schema"]; ok {
+		return ResourceFromMap(schema.(map[string]interface{}))
+	}
+	if t, ok := input["type"]; ok {
+		return t.(string)
+	}
+	return nil
+}
+
+// decodeValidation returns the name of the function used to validate the property, if any
+func decodeValidation(input *schema.Schema) string {
+	var validateFunc interface{}
+	switch {
+	case input.ValidateFunc != nil:
+		validateFunc = input.ValidateFunc
+	case input.ValidateDiagFunc != nil:
+		validateFunc = input.ValidateDiagFunc
+	default:
+		return ""
+	}
+
+	if f := runtime.FuncForPC(reflect.ValueOf(validateFunc).Pointer()); f != nil {
+		return f.Name()
+	}
+	return ""
+}
+
 func decodeConfigMode(input schema.SchemaConfigMode) (out string) {
 	switch input {
 	case 1:
@@ -169,11 +216,13 @@ func ProviderFromRaw(input *ProviderJSON) (*ProviderSchemaJSON, error) {
 		providerSchema[k] = schemaFromRaw(v)
 	}
 
+	deprecatedInFavourOfResources := deprecatedInFavourOfResources()
 	for k, v := range input.ResourcesMap {
 		resource, err := resourceFromRaw(v)
 		if err != nil {
 			return nil, err
 		}
+		resource.DeprecatedInFavourOfResource = deprecatedInFavourOfResources[k]
 		resourceSchemas[k] = *resource
 	}
 
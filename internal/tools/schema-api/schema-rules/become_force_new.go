@@ -0,0 +1,24 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package schema_rules
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tools/schema-api/providerjson"
+)
+
+var _ BreakingChangeRule = becomeForceNew{}
+
+type becomeForceNew struct{}
+
+// Check - Checks that an existing property is not updated to become ForceNew
+func (becomeForceNew) Check(base providerjson.SchemaJSON, current providerjson.SchemaJSON, propertyName string) *string {
+	if base.Type != "" && !base.ForceNew && current.ForceNew {
+		return pointer.To(fmt.Sprintf("Cannot change property %q to ForceNew, as updating it would now recreate the resource", propertyName))
+	}
+
+	return nil
+}
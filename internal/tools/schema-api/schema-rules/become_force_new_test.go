@@ -0,0 +1,41 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package schema_rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tools/schema-api/providerjson"
+)
+
+var becomeForceNewBaseNode = providerjson.SchemaJSON{
+	Type:     providerjson.SchemaTypeString,
+	Optional: true,
+	ForceNew: false,
+}
+
+var becomeForceNewPasses = providerjson.SchemaJSON{
+	Type:     providerjson.SchemaTypeString,
+	Optional: true,
+	ForceNew: false,
+}
+
+var becomeForceNewViolates = providerjson.SchemaJSON{
+	Type:     providerjson.SchemaTypeString,
+	Optional: true,
+	ForceNew: true, // violation
+}
+
+func TestBecomeForceNew_Check(t *testing.T) {
+	data := becomeForceNew{}
+	if res := data.Check(becomeForceNewBaseNode, becomeForceNewPasses, ""); res != nil {
+		t.Errorf("expected no violation, got %+v", res)
+	}
+	if res := data.Check(becomeForceNewBaseNode, becomeForceNewViolates, ""); res == nil {
+		t.Errorf("expected violation, but didn't get one")
+	}
+	if res := data.Check(providerjson.SchemaJSON{}, becomeForceNewViolates, ""); res != nil {
+		t.Errorf("expected no violation for a new property, got %+v", res)
+	}
+}
@@ -0,0 +1,49 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package schema_rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tools/schema-api/providerjson"
+)
+
+var maxItemsReducedBaseNode = providerjson.SchemaJSON{
+	Type:     providerjson.SchemaTypeList,
+	Optional: true,
+	MaxItems: 2,
+}
+
+var maxItemsReducedPasses = providerjson.SchemaJSON{
+	Type:     providerjson.SchemaTypeList,
+	Optional: true,
+	MaxItems: 3,
+}
+
+var maxItemsReducedViolates = providerjson.SchemaJSON{
+	Type:     providerjson.SchemaTypeList,
+	Optional: true,
+	MaxItems: 1, // violation
+}
+
+func TestMaxItemsReduced_Check(t *testing.T) {
+	data := maxItemsReduced{}
+	if res := data.Check(maxItemsReducedBaseNode, maxItemsReducedPasses, ""); res != nil {
+		t.Errorf("expected no violation, got %+v", res)
+	}
+	if res := data.Check(maxItemsReducedBaseNode, maxItemsReducedViolates, ""); res == nil {
+		t.Errorf("expected violation, but didn't get one")
+	}
+
+	unlimited := providerjson.SchemaJSON{
+		Type:     providerjson.SchemaTypeList,
+		Optional: true,
+	}
+	if res := data.Check(unlimited, maxItemsReducedBaseNode, ""); res == nil {
+		t.Errorf("expected violation when adding MaxItems, but didn't get one")
+	}
+	if res := data.Check(maxItemsReducedBaseNode, unlimited, ""); res != nil {
+		t.Errorf("expected no violation when removing MaxItems, got %+v", res)
+	}
+}
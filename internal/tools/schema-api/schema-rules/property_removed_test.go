@@ -0,0 +1,32 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package schema_rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tools/schema-api/providerjson"
+)
+
+var propertyRemovedBaseNode = providerjson.SchemaJSON{
+	Type:     providerjson.SchemaTypeString,
+	Optional: true,
+}
+
+var propertyRemovedPasses = providerjson.SchemaJSON{
+	Type:     providerjson.SchemaTypeString,
+	Optional: true,
+}
+
+var propertyRemovedViolates = providerjson.SchemaJSON{} // violation
+
+func TestPropertyRemoved_Check(t *testing.T) {
+	data := propertyRemoved{}
+	if res := data.Check(propertyRemovedBaseNode, propertyRemovedPasses, ""); res != nil {
+		t.Errorf("expected no violation, got %+v", res)
+	}
+	if res := data.Check(propertyRemovedBaseNode, propertyRemovedViolates, ""); res == nil {
+		t.Errorf("expected violation, but didn't get one")
+	}
+}
@@ -11,12 +11,19 @@ type BreakingChangeRule interface {
 
 var BreakingChangeRules = []BreakingChangeRule{
 	becomeComputedOnly{},
+	becomeForceNew{},
+	maxItemsReduced{},
 	newRequiredPropertyExistingResource{},
 	optionalRemoveComputed{},
 	optionalToRequired{},
+	propertyRemoved{},
 	propertyType{},
+	validationChanged{},
 }
 
 var BreakingChangeRulesDataSource = []BreakingChangeRule{
+	maxItemsReduced{},
+	propertyRemoved{},
 	propertyType{},
+	validationChanged{},
 }
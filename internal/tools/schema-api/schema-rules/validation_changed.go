@@ -0,0 +1,29 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package schema_rules
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tools/schema-api/providerjson"
+)
+
+var _ BreakingChangeRule = validationChanged{}
+
+type validationChanged struct{}
+
+// Check - Checks that validation isn't added to, or changed for, an existing property - since the validation function
+// can't be compared, any change in the validation function is reported so that it can be reviewed
+func (validationChanged) Check(base providerjson.SchemaJSON, current providerjson.SchemaJSON, propertyName string) *string {
+	if base.Type == "" || current.Validation == "" || base.Validation == current.Validation {
+		return nil
+	}
+
+	if base.Validation == "" {
+		return pointer.To(fmt.Sprintf("Validation has been added to property %q (%s), which may reject values which were previously valid", propertyName, current.Validation))
+	}
+
+	return pointer.To(fmt.Sprintf("Validation has changed for property %q (%s to %s), which may reject values which were previously valid", propertyName, base.Validation, current.Validation))
+}
@@ -0,0 +1,49 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package schema_rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tools/schema-api/providerjson"
+)
+
+var validationChangedBaseNode = providerjson.SchemaJSON{
+	Type:       providerjson.SchemaTypeString,
+	Optional:   true,
+	Validation: "github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation.StringIsNotEmpty",
+}
+
+var validationChangedPasses = providerjson.SchemaJSON{
+	Type:       providerjson.SchemaTypeString,
+	Optional:   true,
+	Validation: "github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation.StringIsNotEmpty",
+}
+
+var validationChangedViolates = providerjson.SchemaJSON{
+	Type:       providerjson.SchemaTypeString,
+	Optional:   true,
+	Validation: "github.com/hashicorp/terraform-provider-azurerm/internal/services/example/validate.ExampleName", // violation
+}
+
+func TestValidationChanged_Check(t *testing.T) {
+	data := validationChanged{}
+	if res := data.Check(validationChangedBaseNode, validationChangedPasses, ""); res != nil {
+		t.Errorf("expected no violation, got %+v", res)
+	}
+	if res := data.Check(validationChangedBaseNode, validationChangedViolates, ""); res == nil {
+		t.Errorf("expected violation, but didn't get one")
+	}
+
+	withoutValidation := providerjson.SchemaJSON{
+		Type:     providerjson.SchemaTypeString,
+		Optional: true,
+	}
+	if res := data.Check(withoutValidation, validationChangedViolates, ""); res == nil {
+		t.Errorf("expected violation when adding validation, but didn't get one")
+	}
+	if res := data.Check(validationChangedBaseNode, withoutValidation, ""); res != nil {
+		t.Errorf("expected no violation when removing validation, got %+v", res)
+	}
+}
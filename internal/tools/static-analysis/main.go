@@ -14,7 +14,12 @@ import (
 )
 
 var allRules = map[string]rules.Rule{
-	rules.TypedSDKBitCheck{}.Name(): rules.TypedSDKBitCheck{},
+	rules.TypedSDKBitCheck{}.Name():              rules.TypedSDKBitCheck{},
+	rules.TypedResourceReadMarksAsGone{}.Name():  rules.TypedResourceReadMarksAsGone{},
+	rules.TypedResourceUpdateHasChange{}.Name():  rules.TypedResourceUpdateHasChange{},
+	rules.TypedResourceDataSet{}.Name():          rules.TypedResourceDataSet{},
+	rules.TypedResourceIDValidationFunc{}.Name(): rules.TypedResourceIDValidationFunc{},
+	rules.TypedModelSchemaTags{}.Name():          rules.TypedModelSchemaTags{},
 }
 
 func main() {
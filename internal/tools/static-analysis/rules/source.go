@@ -0,0 +1,358 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package rules
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+)
+
+const modulePath = "github.com/hashicorp/terraform-provider-azurerm"
+
+// sources is shared between the rules so that each package is only parsed once
+var sources = &sourceIndex{
+	fileSet:  token.NewFileSet(),
+	packages: map[string]*packageSource{},
+}
+
+// sourceIndex maps the types found via reflection back to their declarations in the
+// source code, so that the rules can inspect their implementation and report file:line
+type sourceIndex struct {
+	fileSet  *token.FileSet
+	packages map[string]*packageSource
+	root     string
+}
+
+type packageSource struct {
+	// methods is a map of Type Name to Method Name to the Declaration of that Method
+	methods map[string]map[string]*ast.FuncDecl
+
+	// types is a map of Type Name to the Declaration of that Type
+	types map[string]*ast.TypeSpec
+}
+
+// position returns the file:line for pos, relative to the root of the repository
+func (s *sourceIndex) position(pos token.Pos) string {
+	p := s.fileSet.Position(pos)
+	if relative, err := filepath.Rel(s.root, p.Filename); err == nil {
+		p.Filename = relative
+	}
+	return fmt.Sprintf("%s:%d", p.Filename, p.Line)
+}
+
+// method returns the declaration of the method `name` on the type of input, including
+// methods promoted from embedded types declared within the same package
+func (s *sourceIndex) method(input interface{}, name string) (*ast.FuncDecl, error) {
+	t := reflect.TypeOf(input)
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	pkg, err := s.packageFor(t.PkgPath())
+	if err != nil {
+		return nil, err
+	}
+
+	return pkg.method(t.Name(), name, map[string]struct{}{}), nil
+}
+
+// methods returns the declarations of all methods on the type of input which are declared within its package
+func (s *sourceIndex) methods(input interface{}) (map[string]*ast.FuncDecl, error) {
+	t := reflect.TypeOf(input)
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	pkg, err := s.packageFor(t.PkgPath())
+	if err != nil {
+		return nil, err
+	}
+
+	return pkg.methods[t.Name()], nil
+}
+
+// structType returns the declaration of the struct t, following any types defined from another struct in the same package
+func (s *sourceIndex) structType(t reflect.Type) (*ast.StructType, error) {
+	pkg, err := s.packageFor(t.PkgPath())
+	if err != nil {
+		return nil, err
+	}
+
+	name := t.Name()
+	for i := 0; i < 10; i++ {
+		spec, ok := pkg.types[name]
+		if !ok {
+			return nil, fmt.Errorf("type %s.%s was not found", t.PkgPath(), name)
+		}
+
+		switch v := spec.Type.(type) {
+		case *ast.StructType:
+			return v, nil
+		case *ast.Ident:
+			// e.g. `type ExampleModel BaseModel`
+			name = v.Name
+			continue
+		}
+		break
+	}
+	return nil, fmt.Errorf("type %s.%s is not a struct", t.PkgPath(), t.Name())
+}
+
+func (s *sourceIndex) packageFor(pkgPath string) (*packageSource, error) {
+	if pkg, ok := s.packages[pkgPath]; ok {
+		return pkg, nil
+	}
+
+	if s.root == "" {
+		root, err := repositoryRoot()
+		if err != nil {
+			return nil, err
+		}
+		s.root = root
+	}
+
+	if !strings.HasPrefix(pkgPath, modulePath+"/") {
+		return nil, fmt.Errorf("package %q is not within %q", pkgPath, modulePath)
+	}
+	directory := filepath.Join(s.root, filepath.FromSlash(strings.TrimPrefix(pkgPath, modulePath+"/")))
+
+	entries, err := os.ReadDir(directory)
+	if err != nil {
+		return nil, fmt.Errorf("reading %q: %+v", directory, err)
+	}
+
+	pkg := &packageSource{
+		methods: map[string]map[string]*ast.FuncDecl{},
+		types:   map[string]*ast.TypeSpec{},
+	}
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), ".go") || strings.HasSuffix(entry.Name(), "_test.go") {
+			continue
+		}
+
+		file, err := parser.ParseFile(s.fileSet, filepath.Join(directory, entry.Name()), nil, 0)
+		if err != nil {
+			return nil, fmt.Errorf("parsing %q: %+v", entry.Name(), err)
+		}
+
+		for _, decl := range file.Decls {
+			switch d := decl.(type) {
+			case *ast.FuncDecl:
+				typeName := receiverTypeName(d)
+				if typeName == "" {
+					continue
+				}
+				if _, ok := pkg.methods[typeName]; !ok {
+					pkg.methods[typeName] = map[string]*ast.FuncDecl{}
+				}
+				pkg.methods[typeName][d.Name.Name] = d
+
+			case *ast.GenDecl:
+				for _, spec := range d.Specs {
+					if typeSpec, ok := spec.(*ast.TypeSpec); ok {
+						pkg.types[typeSpec.Name.Name] = typeSpec
+					}
+				}
+			}
+		}
+	}
+
+	s.packages[pkgPath] = pkg
+	return pkg, nil
+}
+
+func (p *packageSource) method(typeName, name string, seen map[string]struct{}) *ast.FuncDecl {
+	if _, ok := seen[typeName]; ok {
+		return nil
+	}
+	seen[typeName] = struct{}{}
+
+	if decl, ok := p.methods[typeName][name]; ok {
+		return decl
+	}
+
+	spec, ok := p.types[typeName]
+	if !ok {
+		return nil
+	}
+	structType, ok := spec.Type.(*ast.StructType)
+	if !ok {
+		return nil
+	}
+	for _, field := range structType.Fields.List {
+		if len(field.Names) > 0 {
+			continue
+		}
+		if embedded := typeNameOf(field.Type); embedded != "" {
+			if decl := p.method(embedded, name, seen); decl != nil {
+				return decl
+			}
+		}
+	}
+
+	return nil
+}
+
+// inspectWithReceiverMethods walks the body of decl - and of any methods it calls on the same receiver, its
+// fields or another type in the package (e.g. `r.read(ctx, metadata)`, `r.base.readFunc()` or
+// `AccountResource{}.Read()`) - calling f for each node, stopping once f returns false
+func (s *sourceIndex) inspectWithReceiverMethods(input interface{}, decl *ast.FuncDecl, f func(ast.Node) bool) {
+	t := reflect.TypeOf(input)
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	pkg, err := s.packageFor(t.PkgPath())
+	if err != nil {
+		return
+	}
+
+	seen := map[*ast.FuncDecl]struct{}{}
+	found := false
+
+	var inspect func(decl *ast.FuncDecl)
+	inspect = func(decl *ast.FuncDecl) {
+		if decl == nil || decl.Body == nil {
+			return
+		}
+		if _, ok := seen[decl]; ok {
+			return
+		}
+		seen[decl] = struct{}{}
+
+		receiver := receiverName(decl)
+		receiverType := receiverTypeName(decl)
+		ast.Inspect(decl.Body, func(node ast.Node) bool {
+			if found {
+				return false
+			}
+			if !f(node) {
+				found = true
+				return false
+			}
+
+			call, ok := node.(*ast.CallExpr)
+			if !ok {
+				return true
+			}
+			selector, ok := call.Fun.(*ast.SelectorExpr)
+			if !ok {
+				return true
+			}
+			switch x := selector.X.(type) {
+			case *ast.Ident:
+				// e.g. `r.read(ctx, metadata)`
+				if receiver != "" && x.Name == receiver {
+					inspect(pkg.method(receiverType, selector.Sel.Name, map[string]struct{}{}))
+				}
+			case *ast.CompositeLit:
+				// e.g. `AccountResource{}.Read()`
+				if typeName := typeNameOf(x.Type); typeName != "" {
+					inspect(pkg.method(typeName, selector.Sel.Name, map[string]struct{}{}))
+				}
+			case *ast.SelectorExpr:
+				// e.g. `r.base.readFunc()`
+				if ident, ok := x.X.(*ast.Ident); ok && receiver != "" && ident.Name == receiver {
+					if fieldType := pkg.fieldTypeName(receiverType, x.Sel.Name); fieldType != "" {
+						inspect(pkg.method(fieldType, selector.Sel.Name, map[string]struct{}{}))
+					}
+				}
+			}
+			return true
+		})
+	}
+	inspect(decl)
+}
+
+// callsMethod returns whether the body of decl (or any methods it calls, see inspectWithReceiverMethods)
+// contains a call to a method with one of the specified names
+func (s *sourceIndex) callsMethod(input interface{}, decl *ast.FuncDecl, names ...string) (found bool) {
+	s.inspectWithReceiverMethods(input, decl, func(node ast.Node) bool {
+		call, ok := node.(*ast.CallExpr)
+		if !ok {
+			return true
+		}
+		selector, ok := call.Fun.(*ast.SelectorExpr)
+		if !ok {
+			return true
+		}
+		for _, name := range names {
+			if selector.Sel.Name == name {
+				found = true
+				return false
+			}
+		}
+		return true
+	})
+	return
+}
+
+// fieldTypeName returns the name of the type of the field `name` within the struct typeName, when declared in this package
+func (p *packageSource) fieldTypeName(typeName, name string) string {
+	spec, ok := p.types[typeName]
+	if !ok {
+		return ""
+	}
+	structType, ok := spec.Type.(*ast.StructType)
+	if !ok {
+		return ""
+	}
+	for _, field := range structType.Fields.List {
+		for _, fieldName := range field.Names {
+			if fieldName.Name == name {
+				return typeNameOf(field.Type)
+			}
+		}
+	}
+	return ""
+}
+
+func receiverName(decl *ast.FuncDecl) string {
+	if decl.Recv == nil || len(decl.Recv.List) == 0 || len(decl.Recv.List[0].Names) == 0 {
+		return ""
+	}
+	return decl.Recv.List[0].Names[0].Name
+}
+
+func receiverTypeName(decl *ast.FuncDecl) string {
+	if decl.Recv == nil || len(decl.Recv.List) == 0 {
+		return ""
+	}
+	return typeNameOf(decl.Recv.List[0].Type)
+}
+
+func typeNameOf(input ast.Expr) string {
+	switch v := input.(type) {
+	case *ast.Ident:
+		return v.Name
+	case *ast.StarExpr:
+		return typeNameOf(v.X)
+	}
+	return ""
+}
+
+// repositoryRoot returns the directory containing the go.mod for the Provider, starting
+// from the current working directory
+func repositoryRoot() (string, error) {
+	directory, err := os.Getwd()
+	if err != nil {
+		return "", fmt.Errorf("determining the working directory: %+v", err)
+	}
+
+	for {
+		if _, err := os.Stat(filepath.Join(directory, "go.mod")); err == nil {
+			return directory, nil
+		}
+		parent := filepath.Dir(directory)
+		if parent == directory {
+			return "", fmt.Errorf("unable to find the root of the repository, `go.mod` was not found")
+		}
+		directory = parent
+	}
+}
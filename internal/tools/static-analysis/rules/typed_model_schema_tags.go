@@ -0,0 +1,130 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package rules
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/provider"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+var _ Rule = TypedModelSchemaTags{}
+
+type TypedModelSchemaTags struct{}
+
+func (r TypedModelSchemaTags) Run() (errors []error) {
+	for _, s := range provider.SupportedTypedServices() {
+		for _, resource := range s.Resources() {
+			errors = append(errors, r.check(resource.ResourceType(), resource.ModelObject(), resource.Arguments(), resource.Attributes())...)
+		}
+		for _, datasource := range s.DataSources() {
+			errors = append(errors, r.check(datasource.ResourceType(), datasource.ModelObject(), datasource.Arguments(), datasource.Attributes())...)
+		}
+	}
+
+	return
+}
+
+func (r TypedModelSchemaTags) check(resourceType string, model interface{}, arguments, attributes map[string]*pluginsdk.Schema) []error {
+	modelType := reflect.TypeOf(model)
+	if modelType == nil || modelType.Kind() != reflect.Ptr || modelType.Elem().Kind() != reflect.Struct {
+		// models which aren't a pointer to a struct are reported by `checkBittiness`
+		return nil
+	}
+
+	schema := make(map[string]*pluginsdk.Schema)
+	for k, v := range arguments {
+		schema[k] = v
+	}
+	for k, v := range attributes {
+		schema[k] = v
+	}
+
+	return checkModelAgainstSchema(resourceType, "", modelType.Elem(), schema)
+}
+
+func checkModelAgainstSchema(resourceType, prefix string, model reflect.Type, schema map[string]*pluginsdk.Schema) (errors []error) {
+	if !strings.HasPrefix(model.PkgPath(), modulePath+"/") {
+		// shared models (e.g. for Identity) are checked alongside their schema in go-azure-helpers
+		return nil
+	}
+
+	structType, err := sources.structType(model)
+	if err != nil {
+		return []error{fmt.Errorf("%q: locating model %s: %+v\n", resourceType, model.Name(), err)}
+	}
+	fieldPositions := make(map[string]string)
+	for _, field := range structType.Fields.List {
+		for _, name := range field.Names {
+			fieldPositions[name.Name] = sources.position(name.Pos())
+		}
+	}
+
+	fieldsForSchema := make(map[string]struct{})
+	for i := 0; i < model.NumField(); i++ {
+		field := model.Field(i)
+		tag, ok := field.Tag.Lookup("tfschema")
+		if !ok || field.Anonymous {
+			continue
+		}
+
+		components := strings.Split(tag, ",")
+		hclPath := strings.TrimSpace(components[0])
+		nextMajorVersionOnly := false
+		for _, item := range components[1:] {
+			item = strings.TrimSpace(item)
+			if strings.EqualFold(item, "addedInNextMajorVersion") || strings.EqualFold(item, "removedInNextMajorVersion") {
+				nextMajorVersionOnly = true
+			}
+		}
+		fieldsForSchema[hclPath] = struct{}{}
+
+		v, ok := schema[hclPath]
+		if !ok {
+			if !nextMajorVersionOnly {
+				errors = append(errors, fmt.Errorf("%s: %q: the field %s in model %s has the tfschema tag %q which isn't defined in the Arguments or Attributes\n", fieldPositions[field.Name], resourceType, field.Name, model.Name(), prefix+hclPath))
+			}
+			continue
+		}
+
+		nestedSchema, ok := v.Elem.(*pluginsdk.Resource)
+		if !ok {
+			continue
+		}
+		nestedModel := field.Type
+		if nestedModel.Kind() == reflect.Slice || nestedModel.Kind() == reflect.Array {
+			nestedModel = nestedModel.Elem()
+		}
+		if nestedModel.Kind() == reflect.Struct {
+			errors = append(errors, checkModelAgainstSchema(resourceType, prefix+hclPath+".", nestedModel, nestedSchema.Schema)...)
+		}
+	}
+
+	missing := make([]string, 0)
+	for k := range schema {
+		if _, ok := fieldsForSchema[k]; !ok {
+			missing = append(missing, k)
+		}
+	}
+	sort.Strings(missing)
+	for _, k := range missing {
+		errors = append(errors, fmt.Errorf("%s: %q: the property %q is defined in the schema but no field in model %s has a matching tfschema tag\n", sources.position(structType.Pos()), resourceType, prefix+k, model.Name()))
+	}
+
+	return
+}
+
+func (r TypedModelSchemaTags) Name() string {
+	return "modelSchemaTags"
+}
+
+func (r TypedModelSchemaTags) Description() string {
+	return fmt.Sprintf(`
+The '%s' check function is used to check the 'tfschema' tags on the ModelObject of Typed Resources and Data Sources match the properties defined in the Arguments and Attributes.
+`, r.Name())
+}
@@ -0,0 +1,71 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package rules
+
+import (
+	"fmt"
+	"go/ast"
+	"sort"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/provider"
+)
+
+var _ Rule = TypedResourceDataSet{}
+
+type TypedResourceDataSet struct{}
+
+func (r TypedResourceDataSet) Run() (errors []error) {
+	for _, s := range provider.SupportedTypedServices() {
+		for _, resource := range s.Resources() {
+			errors = append(errors, r.check(resource, resource.ResourceType())...)
+		}
+		for _, datasource := range s.DataSources() {
+			errors = append(errors, r.check(datasource, datasource.ResourceType())...)
+		}
+	}
+
+	return
+}
+
+func (r TypedResourceDataSet) check(input interface{}, resourceType string) (errors []error) {
+	methods, err := sources.methods(input)
+	if err != nil {
+		return []error{fmt.Errorf("%q: locating methods: %+v\n", resourceType, err)}
+	}
+
+	names := make([]string, 0, len(methods))
+	for name := range methods {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		ast.Inspect(methods[name], func(node ast.Node) bool {
+			call, ok := node.(*ast.CallExpr)
+			if !ok {
+				return true
+			}
+			selector, ok := call.Fun.(*ast.SelectorExpr)
+			if !ok || selector.Sel.Name != "Set" {
+				return true
+			}
+			if parent, ok := selector.X.(*ast.SelectorExpr); ok && parent.Sel.Name == "ResourceData" {
+				errors = append(errors, fmt.Errorf("%s: %q: use `metadata.Encode` rather than `metadata.ResourceData.Set` within Typed Resources\n", sources.position(call.Pos()), resourceType))
+			}
+			return true
+		})
+	}
+
+	return
+}
+
+func (r TypedResourceDataSet) Name() string {
+	return "resourceDataSet"
+}
+
+func (r TypedResourceDataSet) Description() string {
+	return fmt.Sprintf(`
+The '%s' check function is used to check Typed Resources and Data Sources set values into the state using 'metadata.Encode' rather than 'metadata.ResourceData.Set'.
+`, r.Name())
+}
@@ -0,0 +1,42 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package rules
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/provider"
+)
+
+var _ Rule = TypedResourceIDValidationFunc{}
+
+type TypedResourceIDValidationFunc struct{}
+
+func (r TypedResourceIDValidationFunc) Run() (errors []error) {
+	for _, s := range provider.SupportedTypedServices() {
+		for _, resource := range s.Resources() {
+			if resource.IDValidationFunc() != nil {
+				continue
+			}
+
+			position := "<unknown>"
+			if decl, err := sources.method(resource, "IDValidationFunc"); err == nil && decl != nil {
+				position = sources.position(decl.Pos())
+			}
+			errors = append(errors, fmt.Errorf("%s: %q: IDValidationFunc should return a function to validate the Resource ID during import\n", position, resource.ResourceType()))
+		}
+	}
+
+	return
+}
+
+func (r TypedResourceIDValidationFunc) Name() string {
+	return "idValidationFunc"
+}
+
+func (r TypedResourceIDValidationFunc) Description() string {
+	return fmt.Sprintf(`
+The '%s' check function is used to check Typed Resources return an IDValidationFunc, which is used to validate the Resource ID during import.
+`, r.Name())
+}
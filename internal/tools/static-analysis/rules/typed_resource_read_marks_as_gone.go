@@ -0,0 +1,45 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package rules
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/provider"
+)
+
+var _ Rule = TypedResourceReadMarksAsGone{}
+
+type TypedResourceReadMarksAsGone struct{}
+
+func (r TypedResourceReadMarksAsGone) Run() (errors []error) {
+	for _, s := range provider.SupportedTypedServices() {
+		for _, resource := range s.Resources() {
+			decl, err := sources.method(resource, "Read")
+			if err != nil {
+				errors = append(errors, fmt.Errorf("%q: locating Read: %+v\n", resource.ResourceType(), err))
+				continue
+			}
+			if decl == nil {
+				continue
+			}
+
+			if !sources.callsMethod(resource, decl, "MarkAsGone") {
+				errors = append(errors, fmt.Errorf("%s: %q: Read should call `metadata.MarkAsGone` when the resource returns a 404\n", sources.position(decl.Pos()), resource.ResourceType()))
+			}
+		}
+	}
+
+	return
+}
+
+func (r TypedResourceReadMarksAsGone) Name() string {
+	return "readMarksAsGone"
+}
+
+func (r TypedResourceReadMarksAsGone) Description() string {
+	return fmt.Sprintf(`
+The '%s' check function is used to check the Read function of Typed Resources calls 'metadata.MarkAsGone' when the resource no longer exists, so that it's removed from the state.
+`, r.Name())
+}
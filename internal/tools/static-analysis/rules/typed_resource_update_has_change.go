@@ -0,0 +1,50 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package rules
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/provider"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+)
+
+var _ Rule = TypedResourceUpdateHasChange{}
+
+type TypedResourceUpdateHasChange struct{}
+
+func (r TypedResourceUpdateHasChange) Run() (errors []error) {
+	for _, s := range provider.SupportedTypedServices() {
+		for _, resource := range s.Resources() {
+			if _, ok := resource.(sdk.ResourceWithUpdate); !ok {
+				continue
+			}
+
+			decl, err := sources.method(resource, "Update")
+			if err != nil {
+				errors = append(errors, fmt.Errorf("%q: locating Update: %+v\n", resource.ResourceType(), err))
+				continue
+			}
+			if decl == nil {
+				continue
+			}
+
+			if !sources.callsMethod(resource, decl, "HasChange", "HasChanges", "HasChangeExcept", "HasChangesExcept") {
+				errors = append(errors, fmt.Errorf("%s: %q: Update should only update the fields which have changed, using `metadata.ResourceData.HasChange`\n", sources.position(decl.Pos()), resource.ResourceType()))
+			}
+		}
+	}
+
+	return
+}
+
+func (r TypedResourceUpdateHasChange) Name() string {
+	return "updateHasChange"
+}
+
+func (r TypedResourceUpdateHasChange) Description() string {
+	return fmt.Sprintf(`
+The '%s' check function is used to check the Update function of Typed Resources is guarded by 'metadata.ResourceData.HasChange', so that only the fields which have changed are updated.
+`, r.Name())
+}
@@ -0,0 +1,290 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package advisor
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tools/schema-api/providerjson"
+)
+
+const (
+	CategoryDeprecated = "deprecated"
+	CategoryRemoved    = "removed"
+	CategoryRenamed    = "renamed"
+)
+
+// Finding is a usage of a Resource, Data Source or property within a Terraform Configuration which needs to be
+// updated when upgrading to the target version of the Provider
+type Finding struct {
+	File string `json:"file"`
+	Line int    `json:"line"`
+
+	// Kind is either `resource` or `data`
+	Kind string `json:"kind"`
+
+	// Type is the Resource Type, for example `azurerm_resource_group`
+	Type string `json:"type"`
+
+	// Name is the name of the Resource or Data Source within the Terraform Configuration
+	Name string `json:"name"`
+
+	// Property is the path to the property, for example `identity.type` - this is empty when the Finding is for the
+	// Resource or Data Source itself
+	Property string `json:"property,omitempty"`
+
+	// Category is one of `deprecated`, `removed` or `renamed`
+	Category string `json:"category"`
+
+	Message string `json:"message"`
+}
+
+func (f Finding) String() string {
+	address := fmt.Sprintf("%s.%s", f.Type, f.Name)
+	if f.Kind == "data" {
+		address = fmt.Sprintf("data.%s", address)
+	}
+	if f.Property != "" {
+		address = fmt.Sprintf("%s: %s", address, f.Property)
+	}
+
+	return fmt.Sprintf("%s:%d: [%s] %s: %s", f.File, f.Line, f.Category, address, f.Message)
+}
+
+// Advisor reports the usages of Resources, Data Sources and properties within Terraform Configurations which
+// are deprecated, renamed or removed between two versions of the Provider schema
+type Advisor struct {
+	// Source is the Provider schema for the version currently in use
+	Source *providerjson.ProviderWrapper
+
+	// Target is the Provider schema for the version being upgraded to
+	Target *providerjson.ProviderWrapper
+}
+
+// meta-arguments and blocks which are defined by Terraform rather than the Provider
+var metaArguments = map[string]struct{}{
+	"count":       {},
+	"depends_on":  {},
+	"for_each":    {},
+	"lifecycle":   {},
+	"provider":    {},
+	"provisioner": {},
+	"connection":  {},
+	"timeouts":    {},
+}
+
+// AnalyseDirectory returns the Findings for all the `.tf` files within the directory (and any sub-directories)
+func (a Advisor) AnalyseDirectory(directory string) ([]Finding, error) {
+	findings := make([]Finding, 0)
+	err := filepath.WalkDir(directory, func(path string, entry os.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if entry.IsDir() {
+			// skip the modules and providers downloaded by Terraform
+			if entry.Name() == ".terraform" {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if filepath.Ext(path) != ".tf" {
+			return nil
+		}
+
+		contents, err := os.ReadFile(path)
+		if err != nil {
+			return fmt.Errorf("reading %q: %+v", path, err)
+		}
+		fileFindings, err := a.AnalyseFile(path, contents)
+		if err != nil {
+			return err
+		}
+		findings = append(findings, fileFindings...)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(findings, func(i, j int) bool {
+		if findings[i].File != findings[j].File {
+			return findings[i].File < findings[j].File
+		}
+		return findings[i].Line < findings[j].Line
+	})
+
+	return findings, nil
+}
+
+// AnalyseFile returns the Findings for the Terraform Configuration within contents
+func (a Advisor) AnalyseFile(fileName string, contents []byte) ([]Finding, error) {
+	file, diags := hclsyntax.ParseConfig(contents, fileName, hcl.InitialPos)
+	if diags.HasErrors() {
+		return nil, fmt.Errorf("parsing %q: %s", fileName, diags.Error())
+	}
+
+	findings := make([]Finding, 0)
+	for _, block := range file.Body.(*hclsyntax.Body).Blocks {
+		if (block.Type != "resource" && block.Type != "data") || len(block.Labels) != 2 {
+			continue
+		}
+
+		var source, target map[string]providerjson.ResourceJSON
+		if block.Type == "resource" {
+			source = a.Source.ProviderSchema.ResourcesMap
+			target = a.Target.ProviderSchema.ResourcesMap
+		} else {
+			source = a.Source.ProviderSchema.DataSourcesMap
+			target = a.Target.ProviderSchema.DataSourcesMap
+		}
+
+		findings = append(findings, analyseBlock(fileName, block, source, target)...)
+	}
+
+	return findings, nil
+}
+
+func analyseBlock(fileName string, block *hclsyntax.Block, source, target map[string]providerjson.ResourceJSON) []Finding {
+	resourceType := block.Labels[0]
+	finding := func(rng hcl.Range, property, category, message string) Finding {
+		return Finding{
+			File:     fileName,
+			Line:     rng.Start.Line,
+			Kind:     block.Type,
+			Type:     resourceType,
+			Name:     block.Labels[1],
+			Property: property,
+			Category: category,
+			Message:  message,
+		}
+	}
+
+	sourceResource, inSource := source[resourceType]
+	targetResource, inTarget := target[resourceType]
+	if !inSource && !inTarget {
+		// not a Resource from this Provider
+		return nil
+	}
+
+	if !inTarget {
+		replacement := sourceResource.DeprecatedInFavourOfResource
+		if replacement != "" {
+			return []Finding{finding(block.TypeRange, "", CategoryRenamed, fmt.Sprintf("has been removed in favour of %q", replacement))}
+		}
+		return []Finding{finding(block.TypeRange, "", CategoryRemoved, "has been removed")}
+	}
+
+	findings := make([]Finding, 0)
+	switch {
+	case targetResource.DeprecatedInFavourOfResource != "":
+		findings = append(findings, finding(block.TypeRange, "", CategoryRenamed, fmt.Sprintf("has been deprecated in favour of %q", targetResource.DeprecatedInFavourOfResource)))
+	case targetResource.DeprecationMessage != "":
+		findings = append(findings, finding(block.TypeRange, "", CategoryDeprecated, firstLine(targetResource.DeprecationMessage)))
+	}
+
+	for _, v := range analyseBody(block.Body, "", sourceResource.Schema, targetResource.Schema) {
+		findings = append(findings, finding(v.rng, v.property, v.category, v.message))
+	}
+
+	return findings
+}
+
+type propertyFinding struct {
+	rng      hcl.Range
+	property string
+	category string
+	message  string
+}
+
+func analyseBody(body *hclsyntax.Body, parentPath string, source, target map[string]providerjson.SchemaJSON) []propertyFinding {
+	findings := make([]propertyFinding, 0)
+	pathFor := func(name string) string {
+		if parentPath == "" {
+			return name
+		}
+		return fmt.Sprintf("%s.%s", parentPath, name)
+	}
+
+	for name, attribute := range body.Attributes {
+		if _, ok := metaArguments[name]; ok && parentPath == "" {
+			continue
+		}
+		if v := analyseProperty(name, source, target); v != nil {
+			v.rng = attribute.NameRange
+			v.property = pathFor(name)
+			findings = append(findings, *v)
+		}
+	}
+
+	for _, block := range body.Blocks {
+		name := block.Type
+		nestedBody := block.Body
+		if name == "dynamic" && len(block.Labels) == 1 {
+			// the contents of a dynamic block are defined within its `content` block
+			name = block.Labels[0]
+			nestedBody = nil
+			for _, v := range block.Body.Blocks {
+				if v.Type == "content" {
+					nestedBody = v.Body
+				}
+			}
+		}
+		if _, ok := metaArguments[name]; ok && parentPath == "" {
+			continue
+		}
+
+		if v := analyseProperty(name, source, target); v != nil {
+			v.rng = block.TypeRange
+			v.property = pathFor(name)
+			findings = append(findings, *v)
+		}
+
+		// removed blocks are reported once, rather than for each nested property
+		sourceNested, _ := source[name].NestedSchema()
+		targetNested, ok := target[name].NestedSchema()
+		if ok && nestedBody != nil {
+			findings = append(findings, analyseBody(nestedBody, pathFor(name), sourceNested, targetNested)...)
+		}
+	}
+
+	sort.SliceStable(findings, func(i, j int) bool {
+		return findings[i].rng.Start.Byte < findings[j].rng.Start.Byte
+	})
+
+	return findings
+}
+
+func analyseProperty(name string, source, target map[string]providerjson.SchemaJSON) *propertyFinding {
+	targetProperty, inTarget := target[name]
+	if !inTarget {
+		if _, inSource := source[name]; inSource {
+			return &propertyFinding{
+				category: CategoryRemoved,
+				message:  "has been removed",
+			}
+		}
+
+		// unknown to both versions, which is reported by Terraform rather than here
+		return nil
+	}
+
+	if targetProperty.Deprecated != "" {
+		return &propertyFinding{
+			category: CategoryDeprecated,
+			message:  firstLine(targetProperty.Deprecated),
+		}
+	}
+
+	return nil
+}
+
+func firstLine(input string) string {
+	return strings.TrimSpace(strings.SplitN(strings.TrimSpace(input), "\n", 2)[0])
+}
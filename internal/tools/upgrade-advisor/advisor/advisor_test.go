@@ -0,0 +1,176 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package advisor
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tools/schema-api/providerjson"
+)
+
+const testConfig = `
+resource "azurerm_example" "test" {
+  name   = "example"
+  legacy = true
+
+  network_rules {
+    default_action = "Deny"
+    bypass         = ["AzureServices"]
+  }
+
+  dynamic "removed_block" {
+    for_each = var.values
+    content {
+      value = removed_block.value
+    }
+  }
+
+  lifecycle {
+    ignore_changes = [tags]
+  }
+}
+
+resource "azurerm_old_example" "test" {
+  name = "example"
+}
+
+resource "azurerm_gone" "test" {
+  name = "example"
+}
+
+data "azurerm_example" "test" {
+  name = "example"
+  sku  = "Standard"
+}
+
+resource "random_string" "test" {
+  length = 5
+}
+`
+
+func TestAdvisorAnalyseDirectory(t *testing.T) {
+	directory := t.TempDir()
+	if err := os.WriteFile(filepath.Join(directory, "main.tf"), []byte(testConfig), 0o600); err != nil {
+		t.Fatalf("writing configuration: %+v", err)
+	}
+
+	exampleSchema := map[string]providerjson.SchemaJSON{
+		"name": {Type: "TypeString", Required: true},
+		"network_rules": {
+			Type:     "TypeList",
+			Optional: true,
+			Elem: providerjson.ResourceJSON{
+				Schema: map[string]providerjson.SchemaJSON{
+					"default_action": {Type: "TypeString", Optional: true},
+					"bypass":         {Type: "TypeSet", Optional: true},
+				},
+			},
+		},
+	}
+	source := &providerjson.ProviderWrapper{
+		ProviderSchema: &providerjson.ProviderSchemaJSON{
+			ResourcesMap: map[string]providerjson.ResourceJSON{
+				"azurerm_example": {
+					Schema: withProperties(exampleSchema, map[string]providerjson.SchemaJSON{
+						"legacy": {Type: "TypeBool", Optional: true},
+						"removed_block": {
+							Type:     "TypeList",
+							Optional: true,
+							Elem: providerjson.ResourceJSON{
+								Schema: map[string]providerjson.SchemaJSON{
+									"value": {Type: "TypeString", Optional: true},
+								},
+							},
+						},
+					}),
+				},
+				"azurerm_old_example": {
+					Schema: exampleSchema,
+				},
+				"azurerm_gone": {
+					Schema: exampleSchema,
+				},
+			},
+			DataSourcesMap: map[string]providerjson.ResourceJSON{
+				"azurerm_example": {
+					Schema: withProperties(exampleSchema, map[string]providerjson.SchemaJSON{
+						"sku": {Type: "TypeString", Optional: true},
+					}),
+				},
+			},
+		},
+	}
+	target := &providerjson.ProviderWrapper{
+		ProviderSchema: &providerjson.ProviderSchemaJSON{
+			ResourcesMap: map[string]providerjson.ResourceJSON{
+				"azurerm_example": {
+					Schema: withProperties(exampleSchema, map[string]providerjson.SchemaJSON{
+						"network_rules": {
+							Type:     "TypeList",
+							Optional: true,
+							Elem: providerjson.ResourceJSON{
+								Schema: map[string]providerjson.SchemaJSON{
+									"default_action": {Type: "TypeString", Optional: true},
+									"bypass":         {Type: "TypeSet", Optional: true, Deprecated: "`bypass` has been deprecated in favour of `bypass_services`\nand will be removed"},
+								},
+							},
+						},
+					}),
+				},
+				"azurerm_old_example": {
+					Schema:                       exampleSchema,
+					DeprecatedInFavourOfResource: "azurerm_example",
+				},
+			},
+			DataSourcesMap: map[string]providerjson.ResourceJSON{
+				"azurerm_example": {
+					Schema: withProperties(exampleSchema, map[string]providerjson.SchemaJSON{
+						"sku": {Type: "TypeString", Optional: true, Deprecated: "`sku` is no longer used"},
+					}),
+				},
+			},
+		},
+	}
+
+	a := Advisor{
+		Source: source,
+		Target: target,
+	}
+	actual, err := a.AnalyseDirectory(directory)
+	if err != nil {
+		t.Fatalf("analysing directory: %+v", err)
+	}
+
+	expected := []Finding{
+		{Line: 4, Kind: "resource", Type: "azurerm_example", Property: "legacy", Category: CategoryRemoved, Message: "has been removed"},
+		{Line: 8, Kind: "resource", Type: "azurerm_example", Property: "network_rules.bypass", Category: CategoryDeprecated, Message: "`bypass` has been deprecated in favour of `bypass_services`"},
+		{Line: 11, Kind: "resource", Type: "azurerm_example", Property: "removed_block", Category: CategoryRemoved, Message: "has been removed"},
+		{Line: 23, Kind: "resource", Type: "azurerm_old_example", Category: CategoryRenamed, Message: `has been deprecated in favour of "azurerm_example"`},
+		{Line: 27, Kind: "resource", Type: "azurerm_gone", Category: CategoryRemoved, Message: "has been removed"},
+		{Line: 33, Kind: "data", Type: "azurerm_example", Property: "sku", Category: CategoryDeprecated, Message: "`sku` is no longer used"},
+	}
+	if len(actual) != len(expected) {
+		t.Fatalf("expected %d findings but got %d: %+v", len(expected), len(actual), actual)
+	}
+	for i, v := range expected {
+		v.File = filepath.Join(directory, "main.tf")
+		v.Name = "test"
+		if actual[i] != v {
+			t.Fatalf("expected finding %d to be %+v but got %+v", i, v, actual[i])
+		}
+	}
+}
+
+func withProperties(input map[string]providerjson.SchemaJSON, properties map[string]providerjson.SchemaJSON) map[string]providerjson.SchemaJSON {
+	output := make(map[string]providerjson.SchemaJSON)
+	for k, v := range input {
+		output[k] = v
+	}
+	for k, v := range properties {
+		output[k] = v
+	}
+	return output
+}
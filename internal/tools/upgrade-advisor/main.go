@@ -0,0 +1,69 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tools/schema-api/providerjson"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tools/upgrade-advisor/advisor"
+)
+
+func main() {
+	f := flag.NewFlagSet("upgrade-advisor", flag.ExitOnError)
+
+	sourceSchema := f.String("from", "", "the path to the Provider schema (as exported by the schema-api tool) for the version currently in use")
+	targetSchema := f.String("to", "", "the path to the Provider schema (as exported by the schema-api tool) for the version being upgraded to")
+	path := f.String("path", ".", "the directory containing the Terraform Configurations to check")
+	outputFormat := f.String("output-format", "text", "the format of the report, either `text` or `json`")
+	errorOnFindings := f.Bool("error-on-findings", false, "should the tool exit with a non-zero error code when anything needs updating. Defaults to `false`")
+
+	if err := f.Parse(os.Args[1:]); err != nil {
+		log.Fatalf("error parsing args: %+v", err)
+	}
+	if *sourceSchema == "" || *targetSchema == "" {
+		log.Fatalf("both `-from` and `-to` must be specified")
+	}
+
+	source, err := providerjson.LoadFromFile(*sourceSchema)
+	if err != nil {
+		log.Fatalf("loading the Provider schema from %q: %+v", *sourceSchema, err)
+	}
+	target, err := providerjson.LoadFromFile(*targetSchema)
+	if err != nil {
+		log.Fatalf("loading the Provider schema from %q: %+v", *targetSchema, err)
+	}
+
+	a := advisor.Advisor{
+		Source: source,
+		Target: target,
+	}
+	findings, err := a.AnalyseDirectory(*path)
+	if err != nil {
+		log.Fatalf("analysing %q: %+v", *path, err)
+	}
+
+	switch *outputFormat {
+	case "text":
+		for _, v := range findings {
+			fmt.Println(v.String())
+		}
+	case "json":
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		if err := encoder.Encode(findings); err != nil {
+			log.Fatalf("writing findings: %+v", err)
+		}
+	default:
+		log.Fatalf("unsupported output format %q, expected `text` or `json`", *outputFormat)
+	}
+
+	if len(findings) > 0 && *errorOnFindings {
+		os.Exit(1)
+	}
+}
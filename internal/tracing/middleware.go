@@ -0,0 +1,101 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const headerCorrelationRequestId = "x-ms-correlation-request-id"
+
+// requestSpanContextKey is the key used to store the span for a request within its Context, so that the span for the
+// Operation being performed is never ended by EndRequest
+type requestSpanContextKey struct{}
+
+// PrepareRequest is a client.RequestMiddleware which starts a span for the request, within the span of the Operation
+// being performed (if any)
+func (t *Tracer) PrepareRequest(request *http.Request) (*http.Request, error) {
+	ctx, span := t.tracer.Start(request.Context(), fmt.Sprintf("HTTP %s", request.Method),
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			semconv.HTTPRequestMethodKey.String(request.Method),
+			semconv.URLFullKey.String(urlWithoutQuery(request.URL)),
+		),
+	)
+
+	if id := request.Header.Get(headerCorrelationRequestId); id != "" {
+		span.SetAttributes(attributeCorrelationRequestId.String(id))
+	}
+
+	if op := operationFromContext(ctx); op != nil && op.isPoll(request.URL) {
+		span.SetAttributes(attributeLongRunningOperationPoll.Bool(true))
+	}
+
+	return request.WithContext(context.WithValue(ctx, requestSpanContextKey{}, span)), nil
+}
+
+// EndRequest is a client.ResponseMiddleware which ends the span for the request, recording the status code and any
+// Long Running Operation started (or polled) by the request within the Operation being performed
+func (t *Tracer) EndRequest(request *http.Request, response *http.Response) (*http.Response, error) {
+	span, ok := request.Context().Value(requestSpanContextKey{}).(trace.Span)
+	if !ok {
+		return response, nil
+	}
+	now := time.Now()
+
+	correlationRequestId := request.Header.Get(headerCorrelationRequestId)
+	if correlationRequestId == "" {
+		correlationRequestId = response.Header.Get(headerCorrelationRequestId)
+		if correlationRequestId != "" {
+			span.SetAttributes(attributeCorrelationRequestId.String(correlationRequestId))
+		}
+	}
+
+	if op := operationFromContext(request.Context()); op != nil {
+		if correlationRequestId != "" {
+			op.setCorrelationRequestId(correlationRequestId)
+		}
+
+		switch {
+		case op.isPoll(request.URL):
+			op.recordPoll(now, request.URL)
+
+		case request.Method != http.MethodGet && (response.StatusCode == http.StatusCreated || response.StatusCode == http.StatusAccepted):
+			asyncOperation := response.Header.Get("Azure-AsyncOperation")
+			location := response.Header.Get("Location")
+			if asyncOperation != "" || location != "" {
+				op.startLongRunningOperation(now, asyncOperation, location)
+			}
+		}
+	}
+
+	span.SetAttributes(semconv.HTTPResponseStatusCodeKey.Int(response.StatusCode))
+	if response.StatusCode >= http.StatusBadRequest {
+		span.SetStatus(codes.Error, response.Status)
+	}
+	span.End()
+
+	return response, nil
+}
+
+// urlWithoutQuery returns the URL without the query string, which can contain sensitive values such as SAS Tokens
+func urlWithoutQuery(input *url.URL) string {
+	if input == nil {
+		return ""
+	}
+
+	output := *input
+	output.User = nil
+	output.RawQuery = ""
+	output.ForceQuery = false
+	return output.String()
+}
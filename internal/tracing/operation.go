@@ -0,0 +1,159 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tracing
+
+import (
+	"context"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// operationContextKey is the key used to store the Operation within the Context used to perform it
+type operationContextKey struct{}
+
+// Operation is the span for an operation performed on a Resource, which also tracks the time spent polling any
+// Long Running Operations started by the requests sent to Azure whilst performing it
+type Operation struct {
+	tracer *Tracer
+	span   trace.Span
+
+	lock                 sync.Mutex
+	correlationRequestId string
+
+	// longRunningOperations is keyed by the polling URL (without the query string), since an LRO can return both an
+	// `Azure-AsyncOperation` and a `Location` header the same longRunningOperation can be present more than once
+	longRunningOperations map[string]*longRunningOperation
+}
+
+type longRunningOperation struct {
+	// started is when the response which started the LRO was received
+	started time.Time
+
+	// lastPolled is when the most recent response to a polling request was received
+	lastPolled time.Time
+}
+
+func operationFromContext(ctx context.Context) *Operation {
+	if v, ok := ctx.Value(operationContextKey{}).(*Operation); ok {
+		return v
+	}
+	return nil
+}
+
+// End ends the span for the operation, recording the ID of the Resource (which can be empty) and the time spent
+// polling Long Running Operations, then exports it
+func (o *Operation) End(ctx context.Context, resourceId string, err error) {
+	o.lock.Lock()
+	attributes := []attribute.KeyValue{
+		attributeLongRunningOperations.Int(o.countLongRunningOperations()),
+		attributePollingDurationInMS.Int64(o.pollingDuration().Milliseconds()),
+	}
+	if resourceId != "" {
+		attributes = append(attributes, attributeResourceId.String(resourceId))
+	}
+	if o.correlationRequestId != "" {
+		attributes = append(attributes, attributeCorrelationRequestId.String(o.correlationRequestId))
+	}
+	o.lock.Unlock()
+
+	o.span.SetAttributes(attributes...)
+	endSpan(o.span, err)
+
+	// the Context may have been cancelled (e.g. by a timeout) but the spans should still be exported
+	o.tracer.Flush(context.WithoutCancel(ctx))
+}
+
+// countLongRunningOperations returns the number of unique Long Running Operations, the lock must be held
+func (o *Operation) countLongRunningOperations() int {
+	unique := make(map[*longRunningOperation]struct{})
+	for _, v := range o.longRunningOperations {
+		unique[v] = struct{}{}
+	}
+	return len(unique)
+}
+
+// pollingDuration returns the total time spent polling the Long Running Operations, the lock must be held
+func (o *Operation) pollingDuration() time.Duration {
+	unique := make(map[*longRunningOperation]struct{})
+	var output time.Duration
+	for _, v := range o.longRunningOperations {
+		if _, seen := unique[v]; seen {
+			continue
+		}
+		unique[v] = struct{}{}
+
+		if !v.lastPolled.IsZero() {
+			output += v.lastPolled.Sub(v.started)
+		}
+	}
+	return output
+}
+
+func (o *Operation) setCorrelationRequestId(id string) {
+	o.lock.Lock()
+	defer o.lock.Unlock()
+
+	if o.correlationRequestId == "" {
+		o.correlationRequestId = id
+	}
+}
+
+// startLongRunningOperation records that a Long Running Operation, polled using any of the pollingUrls, has started
+func (o *Operation) startLongRunningOperation(now time.Time, pollingUrls ...string) {
+	o.lock.Lock()
+	defer o.lock.Unlock()
+
+	lro := &longRunningOperation{
+		started: now,
+	}
+	for _, v := range pollingUrls {
+		if key := pollingKey(v); key != "" {
+			o.longRunningOperations[key] = lro
+		}
+	}
+}
+
+// isPoll returns whether the request to input polls a Long Running Operation
+func (o *Operation) isPoll(input *url.URL) bool {
+	o.lock.Lock()
+	defer o.lock.Unlock()
+
+	_, ok := o.longRunningOperations[pollingKeyForURL(input)]
+	return ok
+}
+
+// recordPoll records that a response has been received for a polling request to input
+func (o *Operation) recordPoll(now time.Time, input *url.URL) {
+	o.lock.Lock()
+	defer o.lock.Unlock()
+
+	if lro, ok := o.longRunningOperations[pollingKeyForURL(input)]; ok {
+		lro.lastPolled = now
+	}
+}
+
+func pollingKey(input string) string {
+	if input == "" {
+		return ""
+	}
+
+	u, err := url.Parse(input)
+	if err != nil {
+		return ""
+	}
+	return pollingKeyForURL(u)
+}
+
+// pollingKeyForURL returns the host and path of the URL, since the query string can be re-ordered
+func pollingKeyForURL(input *url.URL) string {
+	if input == nil {
+		return ""
+	}
+	return strings.ToLower(input.Host + strings.TrimSuffix(input.Path, "/"))
+}
@@ -0,0 +1,111 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	instrumentationName = "github.com/hashicorp/terraform-provider-azurerm"
+
+	// exportTimeout limits the time spent exporting spans, so that an unavailable collector doesn't block Terraform
+	exportTimeout = 5 * time.Second
+
+	attributeResourceType             = attribute.Key("azurerm.resource_type")
+	attributeResourceId               = attribute.Key("azurerm.resource_id")
+	attributeCorrelationRequestId     = attribute.Key("azurerm.correlation_request_id")
+	attributeLongRunningOperations    = attribute.Key("azurerm.long_running_operation.count")
+	attributePollingDurationInMS      = attribute.Key("azurerm.long_running_operation.polling_duration_ms")
+	attributeLongRunningOperationPoll = attribute.Key("azurerm.long_running_operation.poll")
+)
+
+// Tracer emits OpenTelemetry spans for each operation performed on a Resource and each request sent to Azure
+type Tracer struct {
+	provider *sdktrace.TracerProvider
+	tracer   trace.Tracer
+}
+
+// NewTracer returns a Tracer which exports spans using OTLP over HTTP to the specified endpoint (for example
+// `http://localhost:4318`), or when endpoint is empty, the endpoint specified in the `OTEL_EXPORTER_OTLP_ENDPOINT`
+// or `OTEL_EXPORTER_OTLP_TRACES_ENDPOINT` environment variables - which default to `https://localhost:4318`
+func NewTracer(ctx context.Context, endpoint string, serviceVersion string) (*Tracer, error) {
+	options := []otlptracehttp.Option{
+		otlptracehttp.WithTimeout(exportTimeout),
+		otlptracehttp.WithRetry(otlptracehttp.RetryConfig{
+			Enabled: false,
+		}),
+	}
+	if endpoint != "" {
+		options = append(options, otlptracehttp.WithEndpointURL(endpoint))
+	}
+
+	exporter, err := otlptracehttp.New(ctx, options...)
+	if err != nil {
+		return nil, fmt.Errorf("building the OTLP exporter: %+v", err)
+	}
+
+	return newTracer(sdktrace.WithBatcher(exporter, sdktrace.WithExportTimeout(exportTimeout)), serviceVersion), nil
+}
+
+func newTracer(processor sdktrace.TracerProviderOption, serviceVersion string) *Tracer {
+	provider := sdktrace.NewTracerProvider(
+		processor,
+		sdktrace.WithResource(resource.NewSchemaless(
+			semconv.ServiceName("terraform-provider-azurerm"),
+			semconv.ServiceVersion(serviceVersion),
+		)),
+	)
+
+	return &Tracer{
+		provider: provider,
+		tracer:   provider.Tracer(instrumentationName),
+	}
+}
+
+// StartOperation starts a span for an operation (e.g. `create`) performed on a Resource, the returned Context should
+// be used to perform the operation so that requests sent to Azure are recorded within this span
+func (t *Tracer) StartOperation(ctx context.Context, resourceType string, operation string) (context.Context, *Operation) {
+	ctx, span := t.tracer.Start(ctx, fmt.Sprintf("%s.%s", resourceType, operation), trace.WithAttributes(
+		attributeResourceType.String(resourceType),
+	))
+
+	op := &Operation{
+		tracer:                t,
+		span:                  span,
+		longRunningOperations: make(map[string]*longRunningOperation),
+	}
+	return context.WithValue(ctx, operationContextKey{}, op), op
+}
+
+// Flush exports any spans which have ended, this is called once each operation has completed since the Provider
+// can be stopped at any point once Terraform has the result
+func (t *Tracer) Flush(ctx context.Context) {
+	ctx, cancel := context.WithTimeout(ctx, exportTimeout)
+	defer cancel()
+
+	if err := t.provider.ForceFlush(ctx); err != nil {
+		log.Printf("[DEBUG] Tracing: exporting spans: %+v", err)
+	}
+}
+
+// endSpan ends the span, recording the error (if any)
+func endSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
@@ -0,0 +1,123 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tracing
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+)
+
+func TestTracerOperationWithLongRunningOperation(t *testing.T) {
+	exporter := tracetest.NewInMemoryExporter()
+	tracer := newTracer(sdktrace.WithSyncer(exporter), "1.0.0")
+
+	ctx, op := tracer.StartOperation(context.Background(), "azurerm_example", "create")
+
+	resourceUrl := "https://management.azure.com/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example/providers/Microsoft.Example/examples/example?api-version=2023-01-01"
+	pollingUrl := "https://management.azure.com/subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.Example/locations/westeurope/operationStatuses/1234?api-version=2023-01-01&t=1&sig=abc123"
+
+	send := func(method, url string, statusCode int, headers map[string]string) {
+		request := httptest.NewRequest(method, url, nil).WithContext(ctx)
+		request.Header.Set(headerCorrelationRequestId, "11111111-1111-1111-1111-111111111111")
+
+		request, err := tracer.PrepareRequest(request)
+		if err != nil {
+			t.Fatalf("preparing request: %+v", err)
+		}
+
+		response := &http.Response{
+			StatusCode: statusCode,
+			Status:     http.StatusText(statusCode),
+			Header:     http.Header{},
+		}
+		for k, v := range headers {
+			response.Header.Set(k, v)
+		}
+		if _, err := tracer.EndRequest(request, response); err != nil {
+			t.Fatalf("ending request: %+v", err)
+		}
+	}
+
+	send(http.MethodPut, resourceUrl, http.StatusCreated, map[string]string{"Azure-AsyncOperation": pollingUrl})
+	time.Sleep(10 * time.Millisecond)
+	send(http.MethodGet, pollingUrl, http.StatusOK, nil)
+	send(http.MethodGet, resourceUrl, http.StatusOK, nil)
+
+	op.End(ctx, "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example/providers/Microsoft.Example/examples/example", nil)
+
+	spans := exporter.GetSpans()
+	if len(spans) != 4 {
+		t.Fatalf("expected 4 spans but got %d", len(spans))
+	}
+
+	operation := spans[3]
+	if operation.Name != "azurerm_example.create" {
+		t.Fatalf("expected the last span to be the operation but got %q", operation.Name)
+	}
+	operationAttributes := attributesOf(operation)
+	if v := operationAttributes[attributeLongRunningOperations]; v.AsInt64() != 1 {
+		t.Fatalf("expected 1 long running operation but got %d", v.AsInt64())
+	}
+	if v := operationAttributes[attributePollingDurationInMS]; v.AsInt64() < 10 {
+		t.Fatalf("expected the polling duration to be at least 10ms but got %d", v.AsInt64())
+	}
+	if v := operationAttributes[attributeResourceId]; v.AsString() == "" {
+		t.Fatalf("expected the resource ID to be recorded")
+	}
+	if v := operationAttributes[attributeCorrelationRequestId]; v.AsString() != "11111111-1111-1111-1111-111111111111" {
+		t.Fatalf("expected the correlation request ID to be recorded but got %q", v.AsString())
+	}
+
+	for i, expectedPoll := range []bool{false, true, false} {
+		span := spans[i]
+		if span.Parent.SpanID() != operation.SpanContext.SpanID() {
+			t.Fatalf("expected request %d to be a child of the operation", i)
+		}
+
+		attributes := attributesOf(span)
+		if v := attributes[attributeLongRunningOperationPoll]; v.AsBool() != expectedPoll {
+			t.Fatalf("expected request %d to have poll %t", i, expectedPoll)
+		}
+		if v := attributes[semconv.URLFullKey].AsString(); v != urlWithoutQuery(httptest.NewRequest(http.MethodGet, pollingUrl, nil).URL) && v != urlWithoutQuery(httptest.NewRequest(http.MethodGet, resourceUrl, nil).URL) {
+			t.Fatalf("expected the URL of request %d to omit the query string but got %q", i, v)
+		}
+	}
+}
+
+func TestTracerOperationWithError(t *testing.T) {
+	exporter := tracetest.NewInMemoryExporter()
+	tracer := newTracer(sdktrace.WithSyncer(exporter), "1.0.0")
+
+	ctx, op := tracer.StartOperation(context.Background(), "azurerm_example", "delete")
+	op.End(ctx, "", errors.New("deleting example: unexpected status 409"))
+
+	spans := exporter.GetSpans()
+	if len(spans) != 1 {
+		t.Fatalf("expected 1 span but got %d", len(spans))
+	}
+	if spans[0].Status.Code != codes.Error {
+		t.Fatalf("expected the span to have an error status but got %q", spans[0].Status.Code)
+	}
+	if _, ok := attributesOf(spans[0])[attributeResourceId]; ok {
+		t.Fatalf("expected no resource ID to be recorded")
+	}
+}
+
+func attributesOf(span tracetest.SpanStub) map[attribute.Key]attribute.Value {
+	output := make(map[attribute.Key]attribute.Value)
+	for _, v := range span.Attributes {
+		output[v.Key] = v.Value
+	}
+	return output
+}